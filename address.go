@@ -0,0 +1,158 @@
+package go_printify
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+type Address struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	Country   string `json:"country"`
+	Region    string `json:"region"`
+	Address1  string `json:"address1"`
+	Address2  string `json:"address2,omitempty"`
+	City      string `json:"city"`
+	Zip       string `json:"zip"`
+	Company   string `json:"company,omitempty"`
+}
+
+// ISO 3166-1 alpha-2 country codes
+var countryCodes = newStringSet(`
+	AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE
+	BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD
+	CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM
+	DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF
+	GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU
+	ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN
+	KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME
+	MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA
+	NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM
+	PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI
+	SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK
+	TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI
+	VN VU WF WS YE YT ZA ZM ZW
+`)
+
+type addressRule struct {
+	regionName string
+	regions    map[string]bool
+	zip        *regexp.Regexp
+}
+
+// Country specific requirements, countries not listed here only need the
+// fields required everywhere.
+var addressRules = map[string]addressRule{
+	"US": {
+		regionName: "state",
+		regions: newStringSet(`
+	AL AK AZ AR CA CO CT DE DC FL GA HI ID IL IN IA KS KY LA ME MD MA MI MN MS
+	MO MT NE NV NH NJ NM NY NC ND OH OK OR PA RI SC SD TN TX UT VT VA WA WV WI
+	WY AS GU MP PR VI UM AA AE AP
+`),
+		zip: regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+	},
+	"CA": {
+		regionName: "province",
+		regions:    newStringSet(`AB BC MB NB NL NS NT NU ON PE QC SK YT`),
+		zip:        regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+	},
+	"AU": {
+		regionName: "state",
+		regions:    newStringSet(`ACT NSW NT QLD SA TAS VIC WA`),
+		zip:        regexp.MustCompile(`^\d{4}$`),
+	},
+	"GB": {
+		zip: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+	},
+	"DE": {
+		zip: regexp.MustCompile(`^\d{5}$`),
+	},
+	"FR": {
+		zip: regexp.MustCompile(`^\d{5}$`),
+	},
+}
+
+func newStringSet(values string) map[string]bool {
+	set := map[string]bool{}
+	for _, value := range strings.Fields(values) {
+		set[value] = true
+	}
+	return set
+}
+
+/*
+Normalize trims every field and upper-cases the country and region codes and the zip code
+*/
+func (a *Address) Normalize() {
+	a.FirstName = strings.TrimSpace(a.FirstName)
+	a.LastName = strings.TrimSpace(a.LastName)
+	a.Email = strings.TrimSpace(a.Email)
+	a.Phone = strings.TrimSpace(a.Phone)
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	a.Address1 = strings.TrimSpace(a.Address1)
+	a.Address2 = strings.TrimSpace(a.Address2)
+	a.City = strings.TrimSpace(a.City)
+	a.Zip = strings.ToUpper(strings.Join(strings.Fields(a.Zip), " "))
+	a.Company = strings.TrimSpace(a.Company)
+	a.Region = strings.TrimSpace(a.Region)
+	if _, ok := addressRules[a.Country]; ok {
+		a.Region = strings.ToUpper(a.Region)
+	}
+}
+
+/*
+Validate checks that the country is a known ISO 3166-1 alpha-2 code and that every field Printify requires for it is set
+*/
+func (a *Address) Validate() error {
+	errs := ValidationErrors{}
+	required := map[string]string{
+		"first_name": a.FirstName,
+		"last_name":  a.LastName,
+		"address1":   a.Address1,
+		"city":       a.City,
+		"country":    a.Country,
+	}
+	for _, field := range []string{"first_name", "last_name", "address1", "city", "country"} {
+		if required[field] == "" {
+			errs = append(errs, &FieldError{Field: field, Message: "is required"})
+		}
+	}
+	if a.Country != "" && !countryCodes[a.Country] {
+		errs = append(errs, &FieldError{Field: "country", Message: fmt.Sprintf("%q is not an ISO 3166-1 alpha-2 code", a.Country)})
+	}
+	if rule, ok := addressRules[a.Country]; ok {
+		if rule.regions != nil {
+			if a.Region == "" {
+				errs = append(errs, &FieldError{Field: "region", Message: fmt.Sprintf("%s is required in %s", rule.regionName, a.Country)})
+			} else if !rule.regions[a.Region] {
+				errs = append(errs, &FieldError{Field: "region", Message: fmt.Sprintf("%q is not a %s of %s", a.Region, rule.regionName, a.Country)})
+			}
+		}
+		if a.Zip == "" {
+			errs = append(errs, &FieldError{Field: "zip", Message: fmt.Sprintf("is required in %s", a.Country)})
+		} else if rule.zip != nil && !rule.zip.MatchString(a.Zip) {
+			errs = append(errs, &FieldError{Field: "zip", Message: fmt.Sprintf("%q is not a valid %s zip code", a.Zip, a.Country)})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+/*
+LogValue keeps customer PII out of logs when an Address is logged through log/slog, only the country is kept
+*/
+func (a Address) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("country", a.Country),
+		slog.String("name", redacted),
+		slog.String("address", redacted),
+		slog.String("contact", redacted),
+	)
+}
@@ -0,0 +1,90 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestAddress_NormalizeAndValidate(t *testing.T) {
+	address := &Address{
+		FirstName: " John ",
+		LastName:  "Smith",
+		Country:   "us ",
+		Region:    " ny",
+		Address1:  "1 Main St ",
+		City:      "New York",
+		Zip:       " 10001 ",
+	}
+	address.Normalize()
+	expected := &Address{
+		FirstName: "John",
+		LastName:  "Smith",
+		Country:   "US",
+		Region:    "NY",
+		Address1:  "1 Main St",
+		City:      "New York",
+		Zip:       "10001",
+	}
+	if !reflect.DeepEqual(address, expected) {
+		fmt.Println(address)
+		t.Fail()
+	}
+	if err := address.Validate(); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+
+	invalid := &Address{FirstName: "Jane", LastName: "Doe", Address1: "1 Rue", City: "Montreal", Country: "CA", Zip: "12345"}
+	err := invalid.Validate()
+	if !errors.Is(err, ErrValidation) {
+		t.Fail()
+	}
+	if err.Error() != `printify: validation failed: region: province is required in CA; zip: "12345" is not a valid CA zip code` {
+		fmt.Println(err)
+		t.Fail()
+	}
+
+	unknown := &Address{FirstName: "Jane", LastName: "Doe", Address1: "1 Rue", City: "Paris", Country: "XX"}
+	if err := unknown.Validate(); err == nil {
+		t.Fail()
+	}
+}
+
+func TestAddress_JSON(t *testing.T) {
+	address := &Address{}
+	err := json.Unmarshal([]byte(`{
+		"first_name": "John",
+		"last_name": "Smith",
+		"email": "example@msn.com",
+		"phone": "0574 69 21 90",
+		"country": "BE",
+		"region": "",
+		"address1": "ExampleBaan 121",
+		"address2": "45",
+		"city": "Retie",
+		"zip": "2470"
+	}`), address)
+	if err != nil || address.Zip != "2470" || address.Address2 != "45" || address.Validate() != nil {
+		fmt.Println(address, err)
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitOrderInvalidAddress(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.Fail()
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	err := client.SubmitOrder(1, &Order{AddressTo: &Address{FirstName: "John", Country: "US"}})
+	errs, ok := err.(ValidationErrors)
+	if !ok || errs[0].Field != "address_to.last_name" {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
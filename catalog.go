@@ -1,8 +1,11 @@
 package go_printify
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 const (
@@ -63,97 +66,236 @@ type ShippingProperties struct {
 }
 
 type priceTag struct {
-	Currency string  `json:"currency"`
-	Cost     float32 `json:"cost"`
+	Currency string `json:"currency"`
+	Cost     Money  `json:"cost"`
+}
+
+// UnmarshalJSON copies the currency of the tag into its cost
+func (p *priceTag) UnmarshalJSON(data []byte) error {
+	type plain priceTag
+	if err := json.Unmarshal(data, (*plain)(p)); err != nil {
+		return err
+	}
+	p.Cost.Currency = strings.ToUpper(p.Currency)
+	return nil
+}
+
+type ListBlueprintsRequest struct {
+	Blueprints []*Blueprint
+}
+
+func (r *ListBlueprintsRequest) GetOperation() string { return "catalog.list_blueprints" }
+func (r *ListBlueprintsRequest) GetMethod() string    { return http.MethodGet }
+func (r *ListBlueprintsRequest) GetPath() string      { return blueprintsPath }
+func (r *ListBlueprintsRequest) GetBody() interface{} { return nil }
+func (r *ListBlueprintsRequest) GetResponseStruct() interface{} {
+	r.Blueprints = make([]*Blueprint, 0)
+	return &r.Blueprints
+}
+
+type GetBlueprintRequest struct {
+	BlueprintId int
+	Blueprint   *Blueprint
+}
+
+func (r *GetBlueprintRequest) GetOperation() string { return "catalog.get_blueprint" }
+func (r *GetBlueprintRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetBlueprintRequest) GetPath() string      { return fmt.Sprintf(blueprintPath, r.BlueprintId) }
+func (r *GetBlueprintRequest) GetBody() interface{} { return nil }
+func (r *GetBlueprintRequest) GetResponseStruct() interface{} {
+	r.Blueprint = &Blueprint{}
+	return r.Blueprint
+}
+
+type GetBlueprintProvidersRequest struct {
+	BlueprintId int
+	Providers   []*PrintProvider
+}
+
+func (r *GetBlueprintProvidersRequest) GetOperation() string {
+	return "catalog.get_blueprint_providers"
+}
+func (r *GetBlueprintProvidersRequest) GetMethod() string { return http.MethodGet }
+func (r *GetBlueprintProvidersRequest) GetPath() string {
+	return fmt.Sprintf(blueprintProvidersPath, r.BlueprintId)
+}
+func (r *GetBlueprintProvidersRequest) GetBody() interface{} { return nil }
+func (r *GetBlueprintProvidersRequest) GetResponseStruct() interface{} {
+	r.Providers = make([]*PrintProvider, 0)
+	return &r.Providers
+}
+
+type GetVariantsRequest struct {
+	BlueprintId int
+	ProviderId  int
+	Provider    *PrintProvider
+}
+
+func (r *GetVariantsRequest) GetOperation() string { return "catalog.get_variants" }
+func (r *GetVariantsRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetVariantsRequest) GetPath() string {
+	return fmt.Sprintf(BlueprintProviderVariantsPath, r.BlueprintId, r.ProviderId)
+}
+func (r *GetVariantsRequest) GetBody() interface{} { return nil }
+func (r *GetVariantsRequest) GetResponseStruct() interface{} {
+	r.Provider = &PrintProvider{}
+	return r.Provider
+}
+
+type GetShippingInformationRequest struct {
+	BlueprintId int
+	ProviderId  int
+	Shipping    *ShippingProperties
+}
+
+func (r *GetShippingInformationRequest) GetOperation() string { return "catalog.get_shipping" }
+func (r *GetShippingInformationRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetShippingInformationRequest) GetPath() string {
+	return fmt.Sprintf(BluePrintProviderShippingPath, r.BlueprintId, r.ProviderId)
+}
+func (r *GetShippingInformationRequest) GetBody() interface{} { return nil }
+func (r *GetShippingInformationRequest) GetResponseStruct() interface{} {
+	r.Shipping = &ShippingProperties{}
+	return r.Shipping
+}
+
+type ListPrintProvidersRequest struct {
+	Providers []*PrintProvider
+}
+
+func (r *ListPrintProvidersRequest) GetOperation() string { return "catalog.list_print_providers" }
+func (r *ListPrintProvidersRequest) GetMethod() string    { return http.MethodGet }
+func (r *ListPrintProvidersRequest) GetPath() string      { return PrintProvidersPath }
+func (r *ListPrintProvidersRequest) GetBody() interface{} { return nil }
+func (r *ListPrintProvidersRequest) GetResponseStruct() interface{} {
+	r.Providers = make([]*PrintProvider, 0)
+	return &r.Providers
+}
+
+type GetPrintProviderRequest struct {
+	ProviderId int
+	Provider   *PrintProvider
+}
+
+func (r *GetPrintProviderRequest) GetOperation() string { return "catalog.get_print_provider" }
+func (r *GetPrintProviderRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetPrintProviderRequest) GetPath() string {
+	return fmt.Sprintf(PrintProviderPath, r.ProviderId)
+}
+func (r *GetPrintProviderRequest) GetBody() interface{} { return nil }
+func (r *GetPrintProviderRequest) GetResponseStruct() interface{} {
+	r.Provider = &PrintProvider{}
+	return r.Provider
 }
 
 /*
 Retrieve a list of available blueprints
 */
 func (c *Client) ListBluePrints() ([]*Blueprint, error) {
-	req, err := c.newRequest(http.MethodGet, blueprintsPath, nil)
-	if err != nil {
-		return nil, err
-	}
-	blueprintList := make([]*Blueprint, 0)
-	_, err = c.do(req, &blueprintList)
-	return blueprintList, err
+	return c.ListBluePrintsWithContext(context.Background())
+}
+
+/*
+Retrieve a list of available blueprints
+*/
+func (c *Client) ListBluePrintsWithContext(ctx context.Context) ([]*Blueprint, error) {
+	request := &ListBlueprintsRequest{}
+	err := c.Execute(ctx, request)
+	return request.Blueprints, err
 }
 
 /*
 Retrieve a specific blueprint
 */
 func (c *Client) GetBlueprint(Id int) (*Blueprint, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf(blueprintPath, Id), nil)
-	if err != nil {
-		return nil, err
-	}
-	blueprint := &Blueprint{}
-	_, err = c.do(req, blueprint)
-	return blueprint, err
+	return c.GetBlueprintWithContext(context.Background(), Id)
+}
+
+/*
+Retrieve a specific blueprint
+*/
+func (c *Client) GetBlueprintWithContext(ctx context.Context, Id int) (*Blueprint, error) {
+	request := &GetBlueprintRequest{BlueprintId: Id}
+	err := c.Execute(ctx, request)
+	return request.Blueprint, err
 }
 
 /*
 Retrieve a list of all print providers that fulfill orders for a specific blueprint
 */
 func (c *Client) GetPrintProviders(blueprintId int) ([]*PrintProvider, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf(blueprintProvidersPath, blueprintId), nil)
-	if err != nil {
-		return nil, err
-	}
-	providers := make([]*PrintProvider, 0)
-	_, err = c.do(req, &providers)
-	return providers, err
+	return c.GetPrintProvidersWithContext(context.Background(), blueprintId)
+}
+
+/*
+Retrieve a list of all print providers that fulfill orders for a specific blueprint
+*/
+func (c *Client) GetPrintProvidersWithContext(ctx context.Context, blueprintId int) ([]*PrintProvider, error) {
+	request := &GetBlueprintProvidersRequest{BlueprintId: blueprintId}
+	err := c.Execute(ctx, request)
+	return request.Providers, err
 }
 
 /*
 Retrieve a list of variants of a blueprint from a specific print provider
 */
 func (c *Client) GetVariants(blueprintId, providerId int) (*PrintProvider, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf(BlueprintProviderVariantsPath, blueprintId, providerId), nil)
-	if err != nil {
-		return nil, err
-	}
-	printProvider := &PrintProvider{}
-	_, err = c.do(req, printProvider)
-	return printProvider, err
+	return c.GetVariantsWithContext(context.Background(), blueprintId, providerId)
+}
+
+/*
+Retrieve a list of variants of a blueprint from a specific print provider
+*/
+func (c *Client) GetVariantsWithContext(ctx context.Context, blueprintId, providerId int) (*PrintProvider, error) {
+	request := &GetVariantsRequest{BlueprintId: blueprintId, ProviderId: providerId}
+	err := c.Execute(ctx, request)
+	return request.Provider, err
 }
 
 /*
 Retrieve shipping information
 */
 func (c *Client) GetShippingInformation(blueprintId, providerId int) (*ShippingProperties, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf(BluePrintProviderShippingPath, blueprintId, providerId), nil)
-	if err != nil {
-		return nil, err
-	}
-	shippingInfo := &ShippingProperties{}
-	_, err = c.do(req, shippingInfo)
-	return shippingInfo, err
+	return c.GetShippingInformationWithContext(context.Background(), blueprintId, providerId)
+}
+
+/*
+Retrieve shipping information
+*/
+func (c *Client) GetShippingInformationWithContext(ctx context.Context, blueprintId, providerId int) (*ShippingProperties, error) {
+	request := &GetShippingInformationRequest{BlueprintId: blueprintId, ProviderId: providerId}
+	err := c.Execute(ctx, request)
+	return request.Shipping, err
 }
 
 /*
 Retrieve a list of available print providers
 */
 func (c *Client) GetAvailablePrintProviders() ([]*PrintProvider, error) {
-	req, err := c.newRequest(http.MethodGet, PrintProvidersPath, nil)
-	if err != nil {
-		return nil, err
-	}
-	providers := make([]*PrintProvider, 0)
-	_, err = c.do(req, &providers)
-	return providers, err
+	return c.GetAvailablePrintProvidersWithContext(context.Background())
+}
+
+/*
+Retrieve a list of available print providers
+*/
+func (c *Client) GetAvailablePrintProvidersWithContext(ctx context.Context) ([]*PrintProvider, error) {
+	request := &ListPrintProvidersRequest{}
+	err := c.Execute(ctx, request)
+	return request.Providers, err
 }
 
 /*
 Retrieve a specific print provider and a list of associated blueprint offerings
 */
 func (c *Client) GetPrintProvider(Id int) (*PrintProvider, error) {
-	req, err := c.newRequest(http.MethodGet, fmt.Sprintf(PrintProviderPath, Id), nil)
-	if err != nil {
-		return nil, err
-	}
-	provider := &PrintProvider{}
-	_, err = c.do(req, provider)
-	return provider, err
+	return c.GetPrintProviderWithContext(context.Background(), Id)
+}
+
+/*
+Retrieve a specific print provider and a list of associated blueprint offerings
+*/
+func (c *Client) GetPrintProviderWithContext(ctx context.Context, Id int) (*PrintProvider, error) {
+	request := &GetPrintProviderRequest{ProviderId: Id}
+	err := c.Execute(ctx, request)
+	return request.Provider, err
 }
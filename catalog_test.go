@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"reflect"
 	"testing"
 )
@@ -88,9 +87,7 @@ func TestClient_ListBluePrints(t *testing.T) {
     }]
 		`))
 	}))
-	serverUrl, _ := url.Parse(s.URL)
-	client := NewClient("bla")
-	client.BaseURL = serverUrl
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
 	defer s.Close()
 	resBlueprints, err := client.ListBluePrints()
 	if err != nil {
@@ -128,9 +125,7 @@ func TestClient_GetBlueprint(t *testing.T) {
      ]
 }`))
 	}))
-	client := NewClient("bla")
-	serverUrl, _ := url.Parse(s.URL)
-	client.BaseURL = serverUrl
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
 	defer s.Close()
 	resBlueprint, err := client.GetBlueprint(blueprint.Id)
 	if err != nil {
@@ -182,9 +177,7 @@ func TestClient_GetAvailablePrintProviders(t *testing.T) {
     }
 ]`))
 	}))
-	client := NewClient("bla")
-	serverUrl, _ := url.Parse(s.URL)
-	client.BaseURL = serverUrl
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
 	defer s.Close()
 	resPrintProviders, err := client.GetAvailablePrintProviders()
 	if err != nil {
@@ -236,9 +229,7 @@ func TestClient_GetPrintProviders(t *testing.T) {
 			}
 	]`))
 	}))
-	client := NewClient("bla")
-	serverUrl, _ := url.Parse(s.URL)
-	client.BaseURL = serverUrl
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
 	defer s.Close()
 	resPrintProviders, err := client.GetPrintProviders(5)
 	if err != nil {
@@ -347,9 +338,7 @@ func TestClient_GetVariants(t *testing.T) {
 	]
 }`))
 	}))
-	client := NewClient("bla")
-	serverUrl, _ := url.Parse(s.URL)
-	client.BaseURL = serverUrl
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
 	defer s.Close()
 	resPp, err := client.GetVariants(3, 5)
 	if err != nil {
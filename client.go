@@ -2,49 +2,104 @@ package go_printify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
 	contentType = "application/json;charset=utf-8"
 	baseURL     = "api.printify.com"
 	scheme      = "https"
+	apiVersion  = "v1"
+	userAgent   = "go-printify v1.0"
 )
 
+/*
+ApiRequest describes a single Printify API call, see Client.Execute.
+GetResponseStruct returns a pointer the response body is decoded into, or nil to discard it.
+*/
 type ApiRequest interface {
 	GetMethod() string
 	GetPath() string
 	GetBody() interface{}
-	GetResponseStruct() *interface{}
+	GetResponseStruct() interface{}
 }
 
 type Client struct {
-	BaseURL    *url.URL
-	ApiVersion string
-	UserAgent  string
-	httpClient *http.Client
-	apiKey     string
+	BaseURL     *url.URL
+	ApiVersion  string
+	UserAgent   string
+	RetryPolicy *RetryPolicy
+	RateLimiter *RateLimiter
+	httpClient  *http.Client
+	apiKey      string
+	logger      *slog.Logger
+	logLevels   LogLevels
+	tracer      Tracer
+	observer    Observer
+	middlewares []Middleware
 }
 
-func NewClient(apiKey string) *Client {
-	return &Client{
-		BaseURL: &url.URL{
+/*
+Create a new Printify API client, see the With* functions for the available options
+*/
+func NewClient(apiKey string, opts ...Option) (*Client, error) {
+	if apiKey == "" {
+		return nil, errors.New("printify: api key must not be empty")
+	}
+	o := &clientOptions{
+		httpClient: http.DefaultClient,
+		baseURL: &url.URL{
 			Scheme: scheme,
 			Host:   baseURL,
 		},
-		UserAgent:  "go-printify v1.0",
-		httpClient: http.DefaultClient,
-		apiKey:     apiKey,
+		apiVersion: apiVersion,
+		userAgent:  userAgent,
+		logLevels:  DefaultLogLevels(),
+	}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	httpClient := o.httpClient
+	if o.timeout != nil {
+		// Copy the client so the timeout does not leak into http.DefaultClient
+		withTimeout := *httpClient
+		withTimeout.Timeout = *o.timeout
+		httpClient = &withTimeout
 	}
+	return &Client{
+		BaseURL:     o.baseURL,
+		ApiVersion:  o.apiVersion,
+		UserAgent:   o.userAgent,
+		RetryPolicy: o.retryPolicy,
+		RateLimiter: o.rateLimiter,
+		httpClient:  httpClient,
+		apiKey:      apiKey,
+		logger:      o.logger,
+		logLevels:   o.logLevels,
+		tracer:      o.tracer,
+		observer:    o.observer,
+		middlewares: o.middlewares,
+	}, nil
 }
 
-func (c *Client) newRequest(method, path string, body interface{}) (*http.Request, error) {
-	rel := &url.URL{Path: fmt.Sprintf("%s/%s", c.ApiVersion, path)}
+func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	rel, err := url.Parse(fmt.Sprintf("%s/%s", c.ApiVersion, path))
+	if err != nil {
+		return nil, err
+	}
 	u := c.BaseURL.ResolveReference(rel)
 	var buf io.ReadWriter
 	if body != nil {
@@ -54,7 +109,7 @@ func (c *Client) newRequest(method, path string, body interface{}) (*http.Reques
 			return nil, err
 		}
 	}
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -67,17 +122,77 @@ func (c *Client) newRequest(method, path string, body interface{}) (*http.Reques
 	return req, nil
 }
 
-func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := c.httpClient.Do(req)
+// do sends the request, retrying it according to the retry policy, and returns
+// the last response along with the number of attempts made.
+func (c *Client) do(req *http.Request, v interface{}) (*http.Response, int, error) {
+	for attempt := 1; ; attempt++ {
+		waited, err := c.RateLimiter.wait(req)
+		if waited > 0 && c.observer != nil {
+			c.observer.ObserveRateLimitWait(Operation(req.Context()), waited)
+		}
+		if err != nil {
+			return nil, attempt, withAttempts(err, attempt)
+		}
+		start := time.Now()
+		resp, err := c.send(req, v)
+		if c.observer != nil {
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			c.observer.ObserveRequest(Operation(req.Context()), status, time.Since(start), err)
+		}
+		delay, retry := c.RetryPolicy.shouldRetry(req, resp, err, attempt)
+		if !retry {
+			c.logAttempt(req, resp, err, attempt, time.Since(start), nil)
+			return resp, attempt, withAttempts(err, attempt)
+		}
+		c.logAttempt(req, resp, err, attempt, time.Since(start), &delay)
+		if err := sleep(req.Context(), delay); err != nil {
+			return resp, attempt, err
+		}
+		if req, err = rewind(req); err != nil {
+			return resp, attempt, err
+		}
+	}
+}
+
+func (c *Client) send(req *http.Request, v interface{}) (*http.Response, error) {
+	resp, err := c.transport()(req)
 	if err != nil {
+		// Surface cancellation and deadline errors as-is so callers can match
+		// them with errors.Is against context.Canceled/DeadlineExceeded.
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, err
 	}
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 	if resp.StatusCode >= 400 {
-		return resp, errors.New(fmt.Sprintf("%d", resp.StatusCode))
+		return resp, newAPIError(resp)
+	}
+	if v == nil {
+		return resp, nil
 	}
 	err = json.NewDecoder(resp.Body).Decode(v)
+	if err == io.EOF {
+		err = nil
+	}
 	return resp, err
 }
+
+func withAttempts(err error, attempts int) error {
+	if err == nil {
+		return nil
+	}
+	if apiErr, ok := err.(*APIError); ok {
+		apiErr.Attempts = attempts
+		return apiErr
+	}
+	if attempts == 1 || err == context.Canceled || err == context.DeadlineExceeded {
+		return err
+	}
+	return &RetryError{Attempts: attempts, Err: err}
+}
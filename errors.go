@@ -0,0 +1,211 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrNotFound     = errors.New("printify: not found")
+	ErrUnauthorized = errors.New("printify: unauthorized")
+	ErrRateLimited  = errors.New("printify: rate limited")
+	ErrValidation   = errors.New("printify: validation failed")
+)
+
+/*
+APIError describes a non-2xx response returned by the Printify API
+*/
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Code       int
+	Message    string
+	Reason     string
+	Fields     map[string][]string
+	Header     http.Header
+	Body       []byte
+	Attempts   int
+}
+
+type errorBody struct {
+	Status  string          `json:"status"`
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Error   string          `json:"error"`
+	Errors  json.RawMessage `json:"errors"`
+}
+
+type errorDetails struct {
+	Reason json.RawMessage `json:"reason"`
+	Code   int             `json:"code"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("printify: %s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if e.Reason != "" && e.Reason != e.Message {
+		msg = fmt.Sprintf("%s: %s", msg, e.Reason)
+	}
+	if len(e.Fields) > 0 {
+		fields := make([]string, 0, len(e.Fields))
+		for field := range e.Fields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		parts := make([]string, 0, len(fields))
+		for _, field := range fields {
+			parts = append(parts, fmt.Sprintf("%s: %s", field, strings.Join(e.Fields[field], ", ")))
+		}
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(parts, "; "))
+	}
+	return msg
+}
+
+/*
+Is reports whether the error matches one of the package sentinel errors
+*/
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity || len(e.Fields) > 0
+	}
+	return false
+}
+
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+	apiErr.Body = body
+	parsed := &errorBody{}
+	if err := json.Unmarshal(body, parsed); err != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+	apiErr.Code = parsed.Code
+	apiErr.Message = parsed.Message
+	if apiErr.Message == "" {
+		apiErr.Message = parsed.Error
+	}
+	apiErr.parseDetails(parsed.Errors)
+	return apiErr
+}
+
+// parseDetails understands the shapes Printify uses for the "errors" member:
+// a plain string, {"reason": "...", "code": n} or {"reason": {"field": ["..."]}}.
+func (e *APIError) parseDetails(raw json.RawMessage) {
+	if len(raw) == 0 {
+		return
+	}
+	var reason string
+	if err := json.Unmarshal(raw, &reason); err == nil {
+		e.Reason = reason
+		return
+	}
+	details := &errorDetails{}
+	if err := json.Unmarshal(raw, details); err != nil {
+		return
+	}
+	if e.Code == 0 {
+		e.Code = details.Code
+	}
+	if len(details.Reason) == 0 {
+		e.Fields = parseFields(raw, "code")
+		return
+	}
+	if err := json.Unmarshal(details.Reason, &reason); err == nil {
+		e.Reason = reason
+		return
+	}
+	e.Fields = parseFields(details.Reason)
+}
+
+func parseFields(raw json.RawMessage, skip ...string) map[string][]string {
+	members := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return nil
+	}
+	for _, key := range skip {
+		delete(members, key)
+	}
+	fields := map[string][]string{}
+	for field, value := range members {
+		var messages []string
+		var message string
+		if err := json.Unmarshal(value, &messages); err == nil {
+			fields[field] = messages
+		} else if err := json.Unmarshal(value, &message); err == nil {
+			fields[field] = []string{message}
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+/*
+FieldError describes a single invalid field detected before sending a request
+*/
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+/*
+ValidationErrors aggregates every invalid field detected before sending a request, it matches ErrValidation
+*/
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, fieldErr := range e {
+		parts = append(parts, fieldErr.Error())
+	}
+	return fmt.Sprintf("printify: validation failed: %s", strings.Join(parts, "; "))
+}
+
+func (e ValidationErrors) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// withPrefix nests the field names of a ValidationErrors under prefix, e.g.
+// "zip" becomes "address_to.zip".
+func withPrefix(prefix string, err error) ValidationErrors {
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		return ValidationErrors{{Field: prefix, Message: err.Error()}}
+	}
+	nested := make(ValidationErrors, 0, len(errs))
+	for _, fieldErr := range errs {
+		nested = append(nested, &FieldError{Field: prefix + "." + fieldErr.Field, Message: fieldErr.Message})
+	}
+	return nested
+}
@@ -0,0 +1,68 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_APIError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(400)
+		_, _ = rw.Write([]byte(`{
+			"status": "error",
+			"code": 10100,
+			"message": "Validation failed.",
+			"errors": {
+				"reason": {
+					"line_items": ["The line items field is required."],
+					"address_to.zip": ["The zip field is required."]
+				},
+				"code": 10100
+			}
+		}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	err := client.SubmitOrder(1, &Order{})
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) {
+		fmt.Println(err)
+		t.FailNow()
+	}
+	fields := map[string][]string{
+		"line_items":     {"The line items field is required."},
+		"address_to.zip": {"The zip field is required."},
+	}
+	if apiErr.StatusCode != 400 || apiErr.Method != http.MethodPost || apiErr.Path != "/v1/shops/1/orders.json" ||
+		apiErr.Code != 10100 || apiErr.Message != "Validation failed." || !reflect.DeepEqual(apiErr.Fields, fields) {
+		fmt.Println(apiErr)
+		t.Fail()
+	}
+	if !errors.Is(err, ErrValidation) || errors.Is(err, ErrNotFound) {
+		t.Fail()
+	}
+}
+
+func TestAPIError_Is(t *testing.T) {
+	cases := map[int]error{
+		404: ErrNotFound,
+		401: ErrUnauthorized,
+		403: ErrUnauthorized,
+		429: ErrRateLimited,
+		422: ErrValidation,
+	}
+	for status, sentinel := range cases {
+		err := &APIError{StatusCode: status}
+		if !errors.Is(err, sentinel) {
+			fmt.Println(status, sentinel)
+			t.Fail()
+		}
+	}
+	if errors.Is(&APIError{StatusCode: 500}, ErrNotFound) {
+		t.Fail()
+	}
+}
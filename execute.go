@@ -0,0 +1,74 @@
+package go_printify
+
+import (
+	"context"
+	"sync"
+)
+
+/*
+Executor is implemented by Client, depend on it to substitute a fake client in tests
+*/
+type Executor interface {
+	Execute(ctx context.Context, request ApiRequest) error
+}
+
+/*
+Request is an ApiRequest for endpoints this library does not cover yet
+
+	products := &ProductPage{}
+	err := client.Execute(ctx, &Request{Method: http.MethodGet, Path: "shops/1/products.json", Response: products})
+*/
+type Request struct {
+	Operation string
+	Method    string
+	Path      string
+	Body      interface{}
+	Response  interface{}
+}
+
+func (r *Request) GetOperation() string           { return r.Operation }
+func (r *Request) GetMethod() string              { return r.Method }
+func (r *Request) GetPath() string                { return r.Path }
+func (r *Request) GetBody() interface{}           { return r.Body }
+func (r *Request) GetResponseStruct() interface{} { return r.Response }
+
+/*
+Execute sends the request and decodes the response into its response struct
+*/
+func (c *Client) Execute(ctx context.Context, request ApiRequest) error {
+	ctx = withOperation(ctx, request)
+	ctx, span := c.startSpan(ctx, request)
+	req, err := c.newRequest(ctx, request.GetMethod(), request.GetPath(), request.GetBody())
+	if err != nil {
+		endSpan(span, nil, nil, 0, err)
+		return err
+	}
+	resp, attempts, err := c.do(req, request.GetResponseStruct())
+	endSpan(span, req, resp, attempts, err)
+	return err
+}
+
+/*
+ExecuteBatch sends the requests using up to concurrency parallel calls, the returned errors are in request order
+*/
+func (c *Client) ExecuteBatch(ctx context.Context, concurrency int, requests ...ApiRequest) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	errs := make([]error, len(requests))
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i, request := range requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, request ApiRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = c.Execute(ctx, request)
+		}(i, request)
+	}
+	wg.Wait()
+	return errs
+}
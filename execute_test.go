@@ -0,0 +1,58 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Execute(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/shops/1/future.json" {
+			rw.WriteHeader(404)
+			return
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"name": "brand new endpoint"}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	var executor Executor = client
+	response := map[string]string{}
+	err := executor.Execute(context.Background(), &Request{Method: http.MethodGet, Path: "shops/1/future.json", Response: &response})
+	if err != nil || response["name"] != "brand new endpoint" {
+		fmt.Println(response, err)
+		t.Fail()
+	}
+}
+
+func TestClient_ExecuteBatch(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/shops/1/orders/1.json":
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 1, "line_items": []}`))
+		case "/v1/catalog/blueprints/3.json":
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 3, "title": "Kids Regular Fit Tee"}`))
+		default:
+			rw.WriteHeader(404)
+		}
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	order := &GetOrderRequest{ShopId: 1, OrderId: 1}
+	blueprint := &GetBlueprintRequest{BlueprintId: 3}
+	missing := &GetOrderRequest{ShopId: 1, OrderId: 2}
+	errs := client.ExecuteBatch(context.Background(), 2, order, blueprint, missing)
+	if errs[0] != nil || errs[1] != nil || errs[2] == nil {
+		fmt.Println(errs)
+		t.Fail()
+	}
+	if *order.Order.Id != 1 || blueprint.Blueprint.Title != "Kids Regular Fit Tee" {
+		fmt.Println(order.Order, blueprint.Blueprint)
+		t.Fail()
+	}
+}
@@ -0,0 +1,125 @@
+package go_printify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var ErrSubmissionUnknown = errors.New("printify: outcome of the order submission is unknown")
+
+// How far before the submission the lookup of an ambiguous submission goes,
+// to allow for clock skew between us and Printify.
+const lookupSlack = time.Hour
+
+/*
+Find the order with the given external id, orders created before since are not searched when since is not zero
+*/
+func (c *Client) FindOrderByExternalId(shopId int, externalId string, since time.Time) (*Order, error) {
+	return c.FindOrderByExternalIdWithContext(context.Background(), shopId, externalId, since)
+}
+
+/*
+Find the order with the given external id, orders created before since are not searched when since is not zero
+*/
+func (c *Client) FindOrderByExternalIdWithContext(ctx context.Context, shopId int, externalId string, since time.Time) (*Order, error) {
+	var found *Order
+	err := c.walkOrdersSince(ctx, shopId, since, func(order *Order) bool {
+		if order.ExternalId != nil && *order.ExternalId == externalId {
+			found = order
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
+
+/*
+Submit an order at most once, keyed by its external id. The shop's orders are searched for the external id
+before submitting and after every submission with an unknown outcome, e.g. a timeout, and an existing order
+is returned instead of creating a duplicate. ErrSubmissionUnknown is returned when the order could not be
+found after the last attempt, calling again within an hour of the first call is then safe.
+*/
+func (c *Client) SubmitOrderIdempotent(shopId int, order *Order) (*Order, error) {
+	return c.SubmitOrderIdempotentWithContext(context.Background(), shopId, order)
+}
+
+/*
+Submit an order at most once, keyed by its external id. The shop's orders are searched for the external id
+before submitting and after every submission with an unknown outcome, e.g. a timeout, and an existing order
+is returned instead of creating a duplicate. ErrSubmissionUnknown is returned when the order could not be
+found after the last attempt, calling again within an hour of the first call is then safe.
+The submission is never retried by the client's RetryPolicy, even with RetryNonIdempotent set,
+only its attempts and delays are used.
+*/
+func (c *Client) SubmitOrderIdempotentWithContext(ctx context.Context, shopId int, order *Order) (*Order, error) {
+	if order.ExternalId == nil || *order.ExternalId == "" {
+		return nil, ValidationErrors{{Field: "external_id", Message: "is required for idempotent submission"}}
+	}
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+	since := time.Now().Add(-lookupSlack)
+	// An earlier call may have created the order without learning about it
+	existing, err := c.FindOrderByExternalIdWithContext(ctx, shopId, *order.ExternalId, since)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	submitCtx := withoutRetries(ctx)
+	for attempt := 1; ; attempt++ {
+		err = c.SubmitOrderWithContext(submitCtx, shopId, order)
+		if err == nil {
+			return order, nil
+		}
+		if !isAmbiguous(ctx, err) {
+			return nil, err
+		}
+		// Give an order created by this attempt time to be listed
+		if sleepErr := sleep(ctx, policy.backoff(attempt)); sleepErr != nil {
+			return nil, fmt.Errorf("%w: order %q: %w; %w", ErrSubmissionUnknown, *order.ExternalId, err, sleepErr)
+		}
+		existing, lookupErr := c.FindOrderByExternalIdWithContext(ctx, shopId, *order.ExternalId, since)
+		if lookupErr == nil {
+			return existing, nil
+		}
+		if !errors.Is(lookupErr, ErrNotFound) {
+			// Without knowing whether the order exists submitting again could duplicate it
+			return nil, fmt.Errorf("%w: order %q: %w; looking it up failed: %w",
+				ErrSubmissionUnknown, *order.ExternalId, err, lookupErr)
+		}
+		if attempt >= policy.MaxAttempts {
+			return nil, fmt.Errorf("%w: order %q was not found after %d attempts: %w",
+				ErrSubmissionUnknown, *order.ExternalId, attempt, err)
+		}
+	}
+}
+
+// isAmbiguous reports whether a failed submission may nevertheless have
+// created the order: the request may have reached Printify unless it was
+// answered with a client error or never left because ctx was done.
+func isAmbiguous(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var validationErrs ValidationErrors
+	if errors.As(err, &validationErrs) || errors.Is(err, ErrRateLimitExceeded) {
+		return false
+	}
+	apiErr := &APIError{}
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_SubmitOrderIdempotent(t *testing.T) {
+	var posts, created int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodPost:
+			atomic.AddInt32(&posts, 1)
+			atomic.StoreInt32(&created, 1)
+			// Create the order but answer too late
+			time.Sleep(100 * time.Millisecond)
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 7}`))
+		case http.MethodGet:
+			rw.WriteHeader(200)
+			if atomic.LoadInt32(&created) == 0 {
+				_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 0, "data": []}`))
+				return
+			}
+			_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 2, "data": [
+				{"id": 6, "external_id": "other", "line_items": []},
+				{"id": 7, "external_id": "ext-7", "line_items": []}
+			]}`))
+		}
+	}))
+	defer s.Close()
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client, _ := NewClient("bla", WithBaseURL(s.URL), WithTimeout(20*time.Millisecond), WithRetryPolicy(policy))
+	order, _ := NewOrderBuilder().ExternalId("ext-7").AddressTo(testAddress).AddSkuItem("SKU", 1).Build()
+	existing, err := client.SubmitOrderIdempotent(1, order)
+	if err != nil || existing.Id == nil || *existing.Id != 7 || atomic.LoadInt32(&posts) != 1 {
+		fmt.Println(existing, err, posts)
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitOrderIdempotentDefinitiveFailure(t *testing.T) {
+	var posts, lookups int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			atomic.AddInt32(&lookups, 1)
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 0, "data": []}`))
+			return
+		}
+		atomic.AddInt32(&posts, 1)
+		rw.WriteHeader(400)
+		_, _ = rw.Write([]byte(`{"status": "error", "code": 8150, "message": "Validation failed."}`))
+	}))
+	defer s.Close()
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	order, _ := NewOrderBuilder().ExternalId("ext-8").AddressTo(testAddress).AddSkuItem("SKU", 1).Build()
+	_, err := client.SubmitOrderIdempotent(1, order)
+	if err == nil || errors.Is(err, ErrSubmissionUnknown) || posts != 1 || lookups != 1 {
+		fmt.Println(err, posts, lookups)
+		t.Fail()
+	}
+	if _, err := client.SubmitOrderIdempotent(1, &Order{}); err == nil {
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitOrderIdempotentIgnoresTransportRetries(t *testing.T) {
+	var posts int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			atomic.AddInt32(&posts, 1)
+			time.Sleep(100 * time.Millisecond)
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 9}`))
+			return
+		}
+		rw.WriteHeader(200)
+		if atomic.LoadInt32(&posts) == 0 {
+			_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 0, "data": []}`))
+			return
+		}
+		_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 1, "data": [{"id": 9, "external_id": "ext-9", "line_items": []}]}`))
+	}))
+	defer s.Close()
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.RetryNonIdempotent = true
+	client, _ := NewClient("bla", WithBaseURL(s.URL), WithTimeout(20*time.Millisecond), WithRetryPolicy(policy))
+	order, _ := NewOrderBuilder().ExternalId("ext-9").AddressTo(testAddress).AddSkuItem("SKU", 1).Build()
+	existing, err := client.SubmitOrderIdempotent(1, order)
+	if err != nil || *existing.Id != 9 || atomic.LoadInt32(&posts) != 1 {
+		fmt.Println(existing, err, posts)
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitOrderIdempotentLookupFailure(t *testing.T) {
+	var posts int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			atomic.AddInt32(&posts, 1)
+			time.Sleep(100 * time.Millisecond)
+		}
+		if atomic.LoadInt32(&posts) == 0 {
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 0, "data": []}`))
+			return
+		}
+		rw.WriteHeader(500)
+	}))
+	defer s.Close()
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client, _ := NewClient("bla", WithBaseURL(s.URL), WithTimeout(20*time.Millisecond), WithRetryPolicy(policy))
+	order, _ := NewOrderBuilder().ExternalId("ext-10").AddressTo(testAddress).AddSkuItem("SKU", 1).Build()
+	_, err := client.SubmitOrderIdempotent(1, order)
+	apiErr := &APIError{}
+	var urlErr *url.Error
+	if !errors.Is(err, ErrSubmissionUnknown) || !errors.As(err, &apiErr) || apiErr.StatusCode != 500 ||
+		!errors.As(err, &urlErr) || atomic.LoadInt32(&posts) != 1 {
+		fmt.Println(err, posts)
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitOrderIdempotentExisting(t *testing.T) {
+	var posts int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			atomic.AddInt32(&posts, 1)
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 1, "data": [{"id": 11, "external_id": "ext-11", "line_items": []}]}`))
+	}))
+	defer s.Close()
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	order, _ := NewOrderBuilder().ExternalId("ext-11").AddressTo(testAddress).AddSkuItem("SKU", 1).Build()
+	existing, err := client.SubmitOrderIdempotent(1, order)
+	if err != nil || *existing.Id != 11 || atomic.LoadInt32(&posts) != 0 {
+		fmt.Println(existing, err, posts)
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitOrderIdempotentUnknown(t *testing.T) {
+	var posts int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			atomic.AddInt32(&posts, 1)
+			rw.WriteHeader(502)
+			return
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "total": 0, "data": []}`))
+	}))
+	defer s.Close()
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client, _ := NewClient("bla", WithBaseURL(s.URL), WithRetryPolicy(policy))
+	order, _ := NewOrderBuilder().ExternalId("ext-12").AddressTo(testAddress).AddSkuItem("SKU", 1).Build()
+	_, err := client.SubmitOrderIdempotent(1, order)
+	apiErr := &APIError{}
+	if !errors.Is(err, ErrSubmissionUnknown) || !errors.As(err, &apiErr) || apiErr.StatusCode != 502 ||
+		atomic.LoadInt32(&posts) != 3 {
+		fmt.Println(err, posts)
+		t.Fail()
+	}
+}
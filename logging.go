@@ -0,0 +1,103 @@
+package go_printify
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+/*
+LogLevels sets the level each kind of diagnostic record is logged at
+*/
+type LogLevels struct {
+	// Request is used for requests that succeeded
+	Request slog.Level
+	// Retry is used for attempts that failed and will be retried
+	Retry slog.Level
+	// Error is used for requests that failed for good
+	Error slog.Level
+}
+
+/*
+Create the default log levels: successful requests at debug, retries at info and failures at warn
+*/
+func DefaultLogLevels() LogLevels {
+	return LogLevels{
+		Request: slog.LevelDebug,
+		Retry:   slog.LevelInfo,
+		Error:   slog.LevelWarn,
+	}
+}
+
+// logAttempt records the outcome of a single attempt. Only the operation and
+// request line are logged, never headers or bodies, so neither the API key
+// nor customer data can end up in the output.
+func (c *Client) logAttempt(req *http.Request, resp *http.Response, err error, attempt int, latency time.Duration, retryIn *time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	ctx := req.Context()
+	level := c.logLevels.Request
+	msg := "printify request"
+	if retryIn != nil {
+		level = c.logLevels.Retry
+		msg = "printify request failed, retrying"
+	} else if err != nil {
+		level = c.logLevels.Error
+		msg = "printify request failed"
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("operation", Operation(ctx)),
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", attempt),
+		slog.Duration("latency", latency),
+	}
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	if retryIn != nil {
+		attrs = append(attrs, slog.Duration("retry_in", *retryIn))
+	}
+	c.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+/*
+LogValue keeps the API key out of logs when a Client is logged through log/slog
+*/
+func (c *Client) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base_url", c.BaseURL.String()),
+		slog.String("api_version", c.ApiVersion),
+		slog.String("api_key", redacted),
+	)
+}
+
+/*
+LogValue keeps customer PII out of logs when an Order is logged through log/slog, only the destination country is kept
+*/
+func (o Order) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, 6)
+	if o.Id != nil {
+		attrs = append(attrs, slog.Int("id", *o.Id))
+	}
+	if o.Status != nil {
+		attrs = append(attrs, slog.String("status", string(*o.Status)))
+	}
+	attrs = append(attrs,
+		slog.Int("line_items", len(o.LineItems)),
+		slog.String("shipping_method", o.ShippingMethod.String()),
+	)
+	if o.AddressTo != nil {
+		attrs = append(attrs, slog.Any("address_to", *o.AddressTo))
+	}
+	return slog.GroupValue(attrs...)
+}
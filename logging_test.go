@@ -0,0 +1,68 @@
+package go_printify
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Logging(t *testing.T) {
+	calls := 0
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		if calls == 1 {
+			rw.WriteHeader(503)
+			return
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`[]`))
+	}))
+	defer s.Close()
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client, _ := NewClient("super-secret-key", WithBaseURL(s.URL), WithLogger(logger), WithRetryPolicy(policy))
+	if _, err := client.ListShops(); err != nil {
+		t.Fatal(err)
+	}
+	logger.Info("client", "client", client)
+	output := buf.String()
+	for _, expected := range []string{
+		"level=INFO msg=\"printify request failed, retrying\" operation=shops.list method=GET path=/v1/shops.json attempt=1",
+		"status=503",
+		"level=DEBUG msg=\"printify request\" operation=shops.list method=GET path=/v1/shops.json attempt=2",
+		"client.api_key=[REDACTED]",
+	} {
+		if !strings.Contains(output, expected) {
+			fmt.Println(output)
+			t.Fail()
+		}
+	}
+	if strings.Contains(output, "super-secret-key") {
+		t.Fail()
+	}
+}
+
+func TestOrder_LogValue(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, nil))
+	id := 5
+	order := &Order{Id: &id, AddressTo: &Address{
+		FirstName: "John",
+		Email:     "john@example.com",
+		Country:   "US",
+	}}
+	logger.Info("order", "order", order)
+	output := buf.String()
+	if strings.Contains(output, "John") || strings.Contains(output, "john@example.com") ||
+		!strings.Contains(output, "order.address_to.country=US") || !strings.Contains(output, "order.id=5") {
+		fmt.Println(output)
+		t.Fail()
+	}
+}
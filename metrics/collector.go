@@ -0,0 +1,240 @@
+/*
+Package metrics collects Printify client metrics and exposes them in the Prometheus text exposition format
+
+	collector := metrics.NewCollector()
+	client, err := printify.NewClient(apiKey, printify.WithObserver(collector))
+	http.Handle("/metrics", collector.Handler())
+*/
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+/*
+DefaultBuckets are the latency histogram upper bounds in seconds
+*/
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type requestKey struct {
+	operation string
+	status    string
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+/*
+Collector is a printify.Observer aggregating counters and histograms labelled by operation and status code
+*/
+type Collector struct {
+	namespace string
+	buckets   []float64
+
+	mu             sync.Mutex
+	requests       map[requestKey]uint64
+	errors         map[requestKey]uint64
+	durations      map[requestKey]*histogram
+	rateLimitWaits map[string]uint64
+	rateLimitTime  map[string]float64
+}
+
+/*
+Option configures a Collector created by NewCollector
+*/
+type Option func(*Collector)
+
+/*
+Prefix every metric name with namespace instead of printify
+*/
+func WithNamespace(namespace string) Option {
+	return func(c *Collector) {
+		c.namespace = namespace
+	}
+}
+
+/*
+Use the given latency histogram buckets, in seconds, instead of DefaultBuckets
+*/
+func WithBuckets(buckets []float64) Option {
+	return func(c *Collector) {
+		c.buckets = append([]float64(nil), buckets...)
+		sort.Float64s(c.buckets)
+	}
+}
+
+func NewCollector(opts ...Option) *Collector {
+	c := &Collector{
+		namespace:      "printify",
+		buckets:        DefaultBuckets,
+		requests:       map[requestKey]uint64{},
+		errors:         map[requestKey]uint64{},
+		durations:      map[requestKey]*histogram{},
+		rateLimitWaits: map[string]uint64{},
+		rateLimitTime:  map[string]float64{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Collector) ObserveRequest(operation string, statusCode int, duration time.Duration, err error) {
+	key := requestKey{operation: operation, status: statusLabel(statusCode)}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests[key]++
+	if err != nil {
+		c.errors[key]++
+	}
+	h, ok := c.durations[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(c.buckets))}
+		c.durations[key] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range c.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+func (c *Collector) ObserveRateLimitWait(operation string, wait time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimitWaits[operation]++
+	c.rateLimitTime[operation] += wait.Seconds()
+}
+
+func statusLabel(statusCode int) string {
+	if statusCode == 0 {
+		return "none"
+	}
+	return strconv.Itoa(statusCode)
+}
+
+/*
+Handler serves the collected metrics in the Prometheus text exposition format
+*/
+func (c *Collector) Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", contentType)
+		_ = c.Write(rw)
+	})
+}
+
+/*
+Write writes the collected metrics in the Prometheus text exposition format
+*/
+func (c *Collector) Write(w io.Writer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bw := bufio.NewWriter(w)
+
+	c.writeHeader(bw, "requests_total", "counter", "Requests sent to the Printify API, including retries.")
+	for _, key := range sortedRequestKeys(c.requests) {
+		fmt.Fprintf(bw, "%s_requests_total{operation=%s,status=%s} %d\n", c.namespace, quote(key.operation), quote(key.status), c.requests[key])
+	}
+
+	c.writeHeader(bw, "request_errors_total", "counter", "Requests to the Printify API that failed.")
+	for _, key := range sortedRequestKeys(c.errors) {
+		fmt.Fprintf(bw, "%s_request_errors_total{operation=%s,status=%s} %d\n", c.namespace, quote(key.operation), quote(key.status), c.errors[key])
+	}
+
+	c.writeHeader(bw, "request_duration_seconds", "histogram", "Latency of requests to the Printify API.")
+	for _, key := range sortedHistogramKeys(c.durations) {
+		h := c.durations[key]
+		labels := fmt.Sprintf("operation=%s,status=%s", quote(key.operation), quote(key.status))
+		for i, bound := range c.buckets {
+			fmt.Fprintf(bw, "%s_request_duration_seconds_bucket{%s,le=%s} %d\n", c.namespace, labels, quote(formatFloat(bound)), h.counts[i])
+		}
+		fmt.Fprintf(bw, "%s_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", c.namespace, labels, h.count)
+		fmt.Fprintf(bw, "%s_request_duration_seconds_sum{%s} %s\n", c.namespace, labels, formatFloat(h.sum))
+		fmt.Fprintf(bw, "%s_request_duration_seconds_count{%s} %d\n", c.namespace, labels, h.count)
+	}
+
+	c.writeHeader(bw, "rate_limit_waits_total", "counter", "Requests held back by the client-side rate limiter.")
+	for _, operation := range sortedKeys(c.rateLimitWaits) {
+		fmt.Fprintf(bw, "%s_rate_limit_waits_total{operation=%s} %d\n", c.namespace, quote(operation), c.rateLimitWaits[operation])
+	}
+
+	c.writeHeader(bw, "rate_limit_wait_seconds_total", "counter", "Time spent waiting for the client-side rate limiter.")
+	for _, operation := range sortedKeys(c.rateLimitTime) {
+		fmt.Fprintf(bw, "%s_rate_limit_wait_seconds_total{operation=%s} %s\n", c.namespace, quote(operation), formatFloat(c.rateLimitTime[operation]))
+	}
+	return bw.Flush()
+}
+
+func (c *Collector) writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s_%s %s\n# TYPE %s_%s %s\n", c.namespace, name, help, c.namespace, name, kind)
+}
+
+func quote(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, "\n", `\n`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	return `"` + value + `"`
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func sortedRequestKeys(m map[requestKey]uint64) []requestKey {
+	keys := make([]requestKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sortRequestKeys(keys)
+	return keys
+}
+
+func sortedHistogramKeys(m map[requestKey]*histogram) []requestKey {
+	keys := make([]requestKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sortRequestKeys(keys)
+	return keys
+}
+
+func sortRequestKeys(keys []requestKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].operation != keys[j].operation {
+			return keys[i].operation < keys[j].operation
+		}
+		return keys[i].status < keys[j].status
+	})
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	switch typed := m.(type) {
+	case map[string]uint64:
+		for key := range typed {
+			keys = append(keys, key)
+		}
+	case map[string]float64:
+		for key := range typed {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	printify "github.com/omrikiei/go-printify"
+)
+
+func TestCollector_Handler(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/v1/shops.json" {
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`[]`))
+			return
+		}
+		rw.WriteHeader(404)
+	}))
+	defer s.Close()
+	collector := NewCollector(WithBuckets([]float64{1, 10}))
+	client, _ := printify.NewClient("bla", printify.WithBaseURL(s.URL), printify.WithObserver(collector))
+	_, _ = client.ListShops()
+	_, _ = client.ListShops()
+	_, _ = client.GetOrderDetails(1, 1)
+	// Requests without an operation name are labelled by their method only
+	_ = client.Execute(context.Background(), &printify.Request{Method: http.MethodGet, Path: "shops/1/future.json?page=2"})
+
+	metricsServer := httptest.NewServer(collector.Handler())
+	defer metricsServer.Close()
+	resp, err := http.Get(metricsServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	output := string(body)
+	for _, expected := range []string{
+		"# TYPE printify_requests_total counter",
+		`printify_requests_total{operation="shops.list",status="200"} 2`,
+		`printify_requests_total{operation="orders.get",status="404"} 1`,
+		`printify_request_errors_total{operation="orders.get",status="404"} 1`,
+		"# TYPE printify_request_duration_seconds histogram",
+		`printify_request_duration_seconds_bucket{operation="shops.list",status="200",le="1"} 2`,
+		`printify_request_duration_seconds_bucket{operation="shops.list",status="200",le="+Inf"} 2`,
+		`printify_request_duration_seconds_count{operation="shops.list",status="200"} 2`,
+		`printify_request_duration_seconds_count{operation="orders.get",status="404"} 1`,
+		`printify_requests_total{operation="GET",status="404"} 1`,
+	} {
+		if !strings.Contains(output, expected) {
+			fmt.Println(output)
+			t.Fail()
+		}
+	}
+	if strings.Contains(output, `printify_request_errors_total{operation="shops.list"`) || strings.Contains(output, "future.json") {
+		t.Fail()
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Fail()
+	}
+}
@@ -0,0 +1,73 @@
+package go_printify
+
+import (
+	"context"
+	"net/http"
+)
+
+/*
+RoundTripperFunc sends a single HTTP request
+*/
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+/*
+Middleware wraps the transport used by the Client, middlewares run in the order they were registered
+
+	func tracing(next printify.RoundTripperFunc) printify.RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Operation", printify.Operation(req.Context()))
+			return next(req)
+		}
+	}
+*/
+type Middleware func(next RoundTripperFunc) RoundTripperFunc
+
+/*
+NamedRequest is implemented by requests that carry a logical operation name such as orders.submit
+*/
+type NamedRequest interface {
+	GetOperation() string
+}
+
+type operationKey struct{}
+
+/*
+Operation returns the logical operation name of the request being sent, e.g. orders.submit,
+or the HTTP method of requests without a name so that it can be used as a metric label
+*/
+func Operation(ctx context.Context) string {
+	op, _ := ctx.Value(operationKey{}).(string)
+	return op
+}
+
+func withOperation(ctx context.Context, request ApiRequest) context.Context {
+	op := ""
+	if named, ok := request.(NamedRequest); ok {
+		op = named.GetOperation()
+	}
+	if op == "" {
+		op = request.GetMethod()
+	}
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+/*
+Use registers middlewares after the ones given to WithMiddleware, it must not be called concurrently with requests
+*/
+func (c *Client) Use(middlewares ...Middleware) {
+	for _, mw := range middlewares {
+		if mw != nil {
+			c.middlewares = append(c.middlewares, mw)
+		}
+	}
+}
+
+// transport chains the registered middlewares around the http client, the
+// first registered middleware sees the request first.
+func (c *Client) transport() RoundTripperFunc {
+	next := RoundTripperFunc(c.httpClient.Do)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next
+}
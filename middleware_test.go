@@ -0,0 +1,52 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_MiddlewareOperation(t *testing.T) {
+	var traceHeader string
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		traceHeader = req.Header.Get("X-Trace")
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"id": 1, "line_items": []}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	operations := make([]string, 0)
+	client.Use(func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			operations = append(operations, Operation(req.Context()))
+			req.Header.Set("X-Trace", "abc")
+			return next(req)
+		}
+	})
+	_, _ = client.GetOrderDetails(1, 1)
+	_, _ = client.SendOrderToProduction(1, 1)
+	if !reflect.DeepEqual(operations, []string{"orders.get", "orders.get", "orders.send_to_production"}) || traceHeader != "abc" {
+		fmt.Println(operations, traceHeader)
+		t.Fail()
+	}
+}
+
+func TestClient_MiddlewareFaultInjection(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.Fail()
+	}))
+	injected := errors.New("injected")
+	client, _ := NewClient("bla", WithBaseURL(s.URL), WithMiddleware(func(next RoundTripperFunc) RoundTripperFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return nil, injected
+		}
+	}))
+	defer s.Close()
+	if _, err := client.ListShops(); !errors.Is(err, injected) {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
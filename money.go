@@ -0,0 +1,163 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrCurrencyMismatch = errors.New("printify: currency mismatch")
+
+// Currencies without minor units, every other currency has two
+var zeroDecimalCurrencies = newStringSet(`BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX VND VUV XAF XOF XPF`)
+
+/*
+Money is an exact amount in the minor units of its currency, e.g. cents.
+Printify sends amounts as integers without a currency, such amounts have an empty Currency
+and can be combined with amounts of any currency.
+*/
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+/*
+Create an amount of the given minor units, the currency is an ISO 4217 code
+*/
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+/*
+CurrencyError is returned when amounts of different currencies are combined, it matches ErrCurrencyMismatch
+*/
+type CurrencyError struct {
+	A string
+	B string
+}
+
+func (e *CurrencyError) Error() string {
+	return fmt.Sprintf("printify: cannot combine %s and %s amounts", e.A, e.B)
+}
+
+func (e *CurrencyError) Is(target error) bool {
+	return target == ErrCurrencyMismatch
+}
+
+func (m Money) currency(other Money) (string, error) {
+	switch {
+	case m.Currency == other.Currency || other.Currency == "":
+		return m.Currency, nil
+	case m.Currency == "":
+		return other.Currency, nil
+	}
+	return "", &CurrencyError{A: m.Currency, B: other.Currency}
+}
+
+/*
+Add returns the sum of both amounts, it fails when their currencies differ
+*/
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.currency(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: currency}, nil
+}
+
+/*
+Sub returns the difference of both amounts, it fails when their currencies differ
+*/
+func (m Money) Sub(other Money) (Money, error) {
+	currency, err := m.currency(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: currency}, nil
+}
+
+/*
+Mul returns the amount multiplied by n, e.g. a unit price by a quantity
+*/
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) decimals() int {
+	if zeroDecimalCurrencies[m.Currency] {
+		return 0
+	}
+	return 2
+}
+
+/*
+Decimal formats the amount in major units, e.g. "12.34" for 1234 cents
+*/
+func (m Money) Decimal() string {
+	decimals := m.decimals()
+	if decimals == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	unit := int64(math.Pow10(decimals))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/unit, decimals, amount%unit)
+}
+
+/*
+Float64 returns the amount in major units, for display and statistics only
+*/
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / math.Pow10(m.decimals())
+}
+
+/*
+String formats the amount in major units followed by its currency, e.g. "12.34 USD"
+*/
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+	return m.Decimal() + " " + m.Currency
+}
+
+/*
+MarshalJSON encodes the amount as an integer of minor units as expected by Printify
+*/
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(m.Amount, 10)), nil
+}
+
+/*
+UnmarshalJSON decodes an integer of minor units, integral numbers such as 100.0 are accepted
+*/
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	if amount, err := number.Int64(); err == nil {
+		m.Amount = amount
+		return nil
+	}
+	value, err := number.Float64()
+	if err != nil || value != math.Trunc(value) {
+		return fmt.Errorf("printify: %s is not an amount of minor units", data)
+	}
+	m.Amount = int64(value)
+	return nil
+}
@@ -0,0 +1,68 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMoney_Arithmetic(t *testing.T) {
+	price := NewMoney(1999, "usd")
+	sum, err := price.Add(Money{Amount: 1})
+	if err != nil || sum != NewMoney(2000, "USD") {
+		fmt.Println(sum, err)
+		t.Fail()
+	}
+	diff, err := price.Mul(3).Sub(NewMoney(997, "USD"))
+	if err != nil || diff.Amount != 5000 {
+		fmt.Println(diff, err)
+		t.Fail()
+	}
+	if _, err := price.Add(NewMoney(1, "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
+
+func TestMoney_Format(t *testing.T) {
+	cases := map[string]Money{
+		"12.34 USD": NewMoney(1234, "USD"),
+		"-0.05 EUR": NewMoney(-5, "EUR"),
+		"500 JPY":   NewMoney(500, "JPY"),
+		"1.00":      {Amount: 100},
+	}
+	for expected, money := range cases {
+		if money.String() != expected {
+			fmt.Println(money.String(), expected)
+			t.Fail()
+		}
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	variant := &ProductVariant{}
+	if err := json.Unmarshal([]byte(`{"price": 2999, "cost": 1050.0}`), variant); err != nil {
+		t.Fatal(err)
+	}
+	if variant.Price.Amount != 2999 || variant.Cost.Amount != 1050 {
+		fmt.Println(variant.Price, variant.Cost)
+		t.Fail()
+	}
+	if err := json.Unmarshal([]byte(`{"price": 29.99}`), variant); err == nil {
+		t.Fail()
+	}
+	encoded, _ := json.Marshal(NewMoney(2999, "USD"))
+	if string(encoded) != "2999" {
+		fmt.Println(string(encoded))
+		t.Fail()
+	}
+	shipping := &ShippingProperties{}
+	if err := json.Unmarshal([]byte(`{"profiles": {"first_item": {"currency": "usd", "cost": 450}}}`), shipping); err != nil {
+		t.Fatal(err)
+	}
+	if shipping.Profiles.FirstItem.Cost != NewMoney(450, "USD") {
+		fmt.Println(shipping.Profiles.FirstItem)
+		t.Fail()
+	}
+}
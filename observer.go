@@ -0,0 +1,14 @@
+package go_printify
+
+import "time"
+
+/*
+Observer receives a measurement for every attempt sent by the Client, see the metrics package for a Prometheus collector.
+Implementations must be safe for concurrent use.
+*/
+type Observer interface {
+	// ObserveRequest is called once per attempt, statusCode is 0 when no response was received
+	ObserveRequest(operation string, statusCode int, duration time.Duration, err error)
+	// ObserveRateLimitWait is called whenever the client-side rate limiter held a request back
+	ObserveRateLimitWait(operation string, wait time.Duration)
+}
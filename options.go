@@ -0,0 +1,199 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+/*
+Option configures a Client created by NewClient
+*/
+type Option func(*clientOptions) error
+
+type clientOptions struct {
+	httpClient  *http.Client
+	baseURL     *url.URL
+	apiVersion  string
+	userAgent   string
+	timeout     *time.Duration
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+	logger      *slog.Logger
+	logLevels   LogLevels
+	tracer      Tracer
+	observer    Observer
+	middlewares []Middleware
+}
+
+/*
+Use the given http.Client instead of http.DefaultClient
+*/
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("printify: http client must not be nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+/*
+Send requests to the given base URL instead of https://api.printify.com
+*/
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("printify: invalid base URL: %v", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("printify: base URL %q must use http or https", baseURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("printify: base URL %q has no host", baseURL)
+		}
+		o.baseURL = u
+		return nil
+	}
+}
+
+/*
+Use the given API version path prefix, v1 by default
+*/
+func WithAPIVersion(version string) Option {
+	return func(o *clientOptions) error {
+		o.apiVersion = version
+		return nil
+	}
+}
+
+/*
+Send the given User-Agent header
+*/
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) error {
+		if userAgent == "" {
+			return errors.New("printify: user agent must not be empty")
+		}
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+/*
+Limit the time spent on each HTTP request, including reading the response body
+*/
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) error {
+		if timeout <= 0 {
+			return errors.New("printify: timeout must be positive")
+		}
+		o.timeout = &timeout
+		return nil
+	}
+}
+
+/*
+Retry failed requests according to the given policy
+*/
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		if policy == nil {
+			return errors.New("printify: retry policy must not be nil")
+		}
+		if err := policy.validate(); err != nil {
+			return err
+		}
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+/*
+Throttle requests with the given rate limiter
+*/
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) error {
+		if limiter == nil {
+			return errors.New("printify: rate limiter must not be nil")
+		}
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+/*
+Write diagnostic output to the given logger, nothing is logged by default
+*/
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) error {
+		if logger == nil {
+			return errors.New("printify: logger must not be nil")
+		}
+		o.logger = logger
+		return nil
+	}
+}
+
+/*
+Log requests at the given levels instead of DefaultLogLevels
+*/
+func WithLogLevels(levels LogLevels) Option {
+	return func(o *clientOptions) error {
+		o.logLevels = levels
+		return nil
+	}
+}
+
+/*
+Open a span for every API operation with the given tracer
+*/
+func WithTracer(tracer Tracer) Option {
+	return func(o *clientOptions) error {
+		if tracer == nil {
+			return errors.New("printify: tracer must not be nil")
+		}
+		o.tracer = tracer
+		return nil
+	}
+}
+
+/*
+Report request measurements to the given observer
+*/
+func WithObserver(observer Observer) Option {
+	return func(o *clientOptions) error {
+		if observer == nil {
+			return errors.New("printify: observer must not be nil")
+		}
+		o.observer = observer
+		return nil
+	}
+}
+
+/*
+Wrap the transport with the given middlewares
+*/
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *clientOptions) error {
+		for _, mw := range middlewares {
+			if mw == nil {
+				return errors.New("printify: middleware must not be nil")
+			}
+		}
+		o.middlewares = append(o.middlewares, middlewares...)
+		return nil
+	}
+}
+
+func (o *clientOptions) validate() error {
+	if o.timeout != nil && o.httpClient != nil && o.httpClient.Timeout != 0 && o.httpClient.Timeout != *o.timeout {
+		return errors.New("printify: WithTimeout conflicts with the timeout of the http client given to WithHTTPClient")
+	}
+	return nil
+}
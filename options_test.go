@@ -0,0 +1,76 @@
+package go_printify
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewClient_Options(t *testing.T) {
+	var path, userAgent string
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		userAgent = req.Header.Get("User-Agent")
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`[]`))
+	}))
+	defer s.Close()
+	order := make([]string, 0)
+	middleware := func(name string) Middleware {
+		return func(next RoundTripperFunc) RoundTripperFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next(req)
+			}
+		}
+	}
+	client, err := NewClient("bla",
+		WithBaseURL(s.URL),
+		WithAPIVersion("v2"),
+		WithUserAgent("test-agent"),
+		WithTimeout(time.Second),
+		WithMiddleware(middleware("first"), middleware("second")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListShops(); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+	if path != "/v2/shops.json" || userAgent != "test-agent" {
+		fmt.Println(path, userAgent)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(order, []string{"first", "second"}) {
+		fmt.Println(order)
+		t.Fail()
+	}
+	if http.DefaultClient.Timeout != 0 {
+		t.Fail()
+	}
+}
+
+func TestNewClient_InvalidOptions(t *testing.T) {
+	cases := map[string][]Option{
+		"relative base url":   {WithBaseURL("/printify")},
+		"unknown scheme":      {WithBaseURL("ftp://api.printify.com")},
+		"negative timeout":    {WithTimeout(-time.Second)},
+		"nil http client":     {WithHTTPClient(nil)},
+		"invalid retries":     {WithRetryPolicy(&RetryPolicy{MaxAttempts: 0})},
+		"nil middleware":      {WithMiddleware(nil)},
+		"conflicting timeout": {WithHTTPClient(&http.Client{Timeout: time.Second}), WithTimeout(time.Minute)},
+	}
+	for name, opts := range cases {
+		if _, err := NewClient("bla", opts...); err == nil {
+			fmt.Println(name)
+			t.Fail()
+		}
+	}
+	if _, err := NewClient(""); err == nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,186 @@
+package go_printify
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+OrderBuilder assembles an Order without juggling pointer fields, Build reports every problem at once
+
+	order, err := NewOrderBuilder().
+		ExternalId("2750e210-39bb-11e9-a503-452618153e4a").
+		AddressTo(address).
+		AddProductItem("5bfd0b66a342bcc9b5563216", 17887, 1).
+		AddSkuItem("MY-SKU", 2).
+		Build()
+*/
+type OrderBuilder struct {
+	order *Order
+}
+
+/*
+PrintItem describes a line item printed on the fly from a blueprint and print provider
+*/
+type PrintItem struct {
+	BlueprintId     int
+	PrintProviderId int
+	VariantId       int
+	// PrintAreas maps a position such as "front" or "back" to an image URL or upload id
+	PrintAreas map[string]string
+	Quantity   int
+}
+
+func NewOrderBuilder() *OrderBuilder {
+	return &OrderBuilder{order: &Order{LineItems: make([]*LineItem, 0)}}
+}
+
+func (b *OrderBuilder) ExternalId(externalId string) *OrderBuilder {
+	b.order.ExternalId = &externalId
+	return b
+}
+
+func (b *OrderBuilder) Label(label string) *OrderBuilder {
+	b.order.Label = &label
+	return b
+}
+
+func (b *OrderBuilder) ShippingMethod(method ShippingMethod) *OrderBuilder {
+	b.order.ShippingMethod = method
+	return b
+}
+
+func (b *OrderBuilder) SendShippingNotification(send bool) *OrderBuilder {
+	b.order.SendShippingNotification = &send
+	return b
+}
+
+func (b *OrderBuilder) AddressTo(address Address) *OrderBuilder {
+	b.order.AddressTo = &address
+	return b
+}
+
+/*
+Add a line item referencing an existing product and one of its variants
+*/
+func (b *OrderBuilder) AddProductItem(productId string, variantId, quantity int) *OrderBuilder {
+	b.order.LineItems = append(b.order.LineItems, &LineItem{
+		ProductId: &productId,
+		VariantId: &variantId,
+		Quantity:  quantity,
+	})
+	return b
+}
+
+/*
+Add a line item referencing a product variant by SKU
+*/
+func (b *OrderBuilder) AddSkuItem(sku string, quantity int) *OrderBuilder {
+	b.order.LineItems = append(b.order.LineItems, &LineItem{
+		Sku:      &sku,
+		Quantity: quantity,
+	})
+	return b
+}
+
+/*
+Add a line item printed on the fly, without creating a product first
+*/
+func (b *OrderBuilder) AddPrintItem(item PrintItem) *OrderBuilder {
+	printAreas := make(map[string]string, len(item.PrintAreas))
+	for position, image := range item.PrintAreas {
+		printAreas[position] = image
+	}
+	b.order.LineItems = append(b.order.LineItems, &LineItem{
+		BlueprintId:     &item.BlueprintId,
+		PrintProviderId: &item.PrintProviderId,
+		VariantId:       &item.VariantId,
+		PrintAreas:      &printAreas,
+		Quantity:        item.Quantity,
+	})
+	return b
+}
+
+/*
+Build validates the order and returns it, the error is a ValidationErrors listing every invalid field
+*/
+func (b *OrderBuilder) Build() (*Order, error) {
+	errs := ValidationErrors{}
+	order := b.order
+	if order.ExternalId != nil && *order.ExternalId == "" {
+		errs = append(errs, &FieldError{Field: "external_id", Message: "must not be empty"})
+	}
+	if order.ShippingMethod != 0 && !order.ShippingMethod.Valid() {
+		errs = append(errs, &FieldError{Field: "shipping_method", Message: fmt.Sprintf("unknown shipping method %d", int(order.ShippingMethod))})
+	}
+	if order.AddressTo == nil {
+		errs = append(errs, &FieldError{Field: "address_to", Message: "is required"})
+	} else {
+		order.AddressTo.Normalize()
+		if err := order.AddressTo.Validate(); err != nil {
+			errs = append(errs, withPrefix("address_to", err)...)
+		}
+	}
+	if len(order.LineItems) == 0 {
+		errs = append(errs, &FieldError{Field: "line_items", Message: "at least one line item is required"})
+	}
+	for i, item := range order.LineItems {
+		errs = append(errs, validateLineItem(fmt.Sprintf("line_items[%d]", i), item)...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return order, nil
+}
+
+// validateLineItem checks the fields required by the mode the line item was
+// added with: product+variant, SKU or blueprint+provider+print areas.
+func validateLineItem(prefix string, item *LineItem) ValidationErrors {
+	errs := ValidationErrors{}
+	if item.Quantity < 1 {
+		errs = append(errs, &FieldError{Field: prefix + ".quantity", Message: "must be at least 1"})
+	}
+	switch {
+	case item.Sku != nil:
+		if *item.Sku == "" {
+			errs = append(errs, &FieldError{Field: prefix + ".sku", Message: "must not be empty"})
+		}
+	case item.ProductId != nil:
+		if *item.ProductId == "" {
+			errs = append(errs, &FieldError{Field: prefix + ".product_id", Message: "must not be empty"})
+		}
+		if item.VariantId == nil || *item.VariantId <= 0 {
+			errs = append(errs, &FieldError{Field: prefix + ".variant_id", Message: "is required"})
+		}
+	default:
+		if item.BlueprintId == nil || *item.BlueprintId <= 0 {
+			errs = append(errs, &FieldError{Field: prefix + ".blueprint_id", Message: "is required"})
+		}
+		if item.PrintProviderId == nil || *item.PrintProviderId <= 0 {
+			errs = append(errs, &FieldError{Field: prefix + ".print_provider_id", Message: "is required"})
+		}
+		if item.VariantId == nil || *item.VariantId <= 0 {
+			errs = append(errs, &FieldError{Field: prefix + ".variant_id", Message: "is required"})
+		}
+		if item.PrintAreas == nil || len(*item.PrintAreas) == 0 {
+			errs = append(errs, &FieldError{Field: prefix + ".print_areas", Message: "at least one print area is required"})
+		}
+		if item.PrintAreas != nil {
+			for _, position := range sortedPositions(*item.PrintAreas) {
+				if (*item.PrintAreas)[position] == "" {
+					errs = append(errs, &FieldError{Field: prefix + ".print_areas." + position, Message: "image must not be empty"})
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func sortedPositions(printAreas map[string]string) []string {
+	positions := make([]string, 0, len(printAreas))
+	for position := range printAreas {
+		positions = append(positions, position)
+	}
+	sort.Strings(positions)
+	return positions
+}
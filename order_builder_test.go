@@ -0,0 +1,88 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+var testAddress = Address{
+	FirstName: "John",
+	LastName:  "Smith",
+	Email:     "example@msn.com",
+	Country:   "US",
+	Region:    "NY",
+	Address1:  "1 Main St",
+	City:      "New York",
+	Zip:       "10001",
+}
+
+func TestOrderBuilder_Build(t *testing.T) {
+	order, err := NewOrderBuilder().
+		ExternalId("2750e210").
+		Label("00012").
+		ShippingMethod(1).
+		SendShippingNotification(false).
+		AddressTo(testAddress).
+		AddProductItem("5bfd0b66a342bcc9b5563216", 17887, 1).
+		AddSkuItem("MY-SKU", 2).
+		AddPrintItem(PrintItem{BlueprintId: 384, PrintProviderId: 1, VariantId: 45740, Quantity: 1,
+			PrintAreas: map[string]string{"front": "https://images.example.com/image.png"}}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(order)
+	decoded := map[string]interface{}{}
+	_ = json.Unmarshal(body, &decoded)
+	expected := map[string]interface{}{
+		"external_id":                "2750e210",
+		"label":                      "00012",
+		"shipping_method":            float64(1),
+		"send_shipping_notification": false,
+		"address_to": map[string]interface{}{
+			"first_name": "John", "last_name": "Smith", "email": "example@msn.com", "country": "US",
+			"region": "NY", "address1": "1 Main St", "city": "New York", "zip": "10001",
+		},
+		"line_items": []interface{}{
+			map[string]interface{}{"product_id": "5bfd0b66a342bcc9b5563216", "variant_id": float64(17887), "quantity": float64(1)},
+			map[string]interface{}{"sku": "MY-SKU", "quantity": float64(2)},
+			map[string]interface{}{"blueprint_id": float64(384), "print_provider_id": float64(1), "variant_id": float64(45740),
+				"quantity": float64(1), "print_areas": map[string]interface{}{"front": "https://images.example.com/image.png"}},
+		},
+	}
+	if !reflect.DeepEqual(decoded, expected) {
+		fmt.Println(string(body))
+		t.Fail()
+	}
+}
+
+func TestOrderBuilder_BuildErrors(t *testing.T) {
+	_, err := NewOrderBuilder().
+		AddProductItem("", 0, 1).
+		AddSkuItem("SKU", 0).
+		AddPrintItem(PrintItem{BlueprintId: 384, Quantity: 1}).
+		Build()
+	if !errors.Is(err, ErrValidation) {
+		t.Fatal(err)
+	}
+	fields := make([]string, 0)
+	for _, fieldErr := range err.(ValidationErrors) {
+		fields = append(fields, fieldErr.Field)
+	}
+	expected := []string{
+		"address_to",
+		"line_items[0].product_id",
+		"line_items[0].variant_id",
+		"line_items[1].quantity",
+		"line_items[2].print_provider_id",
+		"line_items[2].variant_id",
+		"line_items[2].print_areas",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		fmt.Println(fields)
+		t.Fail()
+	}
+}
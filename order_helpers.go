@@ -0,0 +1,157 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const (
+	PositionFront = "front"
+	PositionBack  = "back"
+)
+
+/*
+SkuItem is a line item referencing a product variant by SKU
+*/
+type SkuItem struct {
+	Sku      string
+	Quantity int
+}
+
+/*
+Submit an order referencing product variants by SKU only
+*/
+func (c *Client) SubmitSkuOrder(shopId int, externalId string, address Address, items ...SkuItem) (*Order, error) {
+	return c.SubmitSkuOrderWithContext(context.Background(), shopId, externalId, address, items...)
+}
+
+/*
+Submit an order referencing product variants by SKU only
+*/
+func (c *Client) SubmitSkuOrderWithContext(ctx context.Context, shopId int, externalId string, address Address, items ...SkuItem) (*Order, error) {
+	builder := NewOrderBuilder().ExternalId(externalId).AddressTo(address)
+	for _, item := range items {
+		builder.AddSkuItem(item.Sku, item.Quantity)
+	}
+	order, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return order, c.SubmitOrderWithContext(ctx, shopId, order)
+}
+
+/*
+Submit a one-off order printed on the fly. Every variant is checked against the catalog first and
+print areas given as upload ids rather than URLs are resolved to the uploaded image
+*/
+func (c *Client) SubmitCustomOrder(shopId int, externalId string, address Address, items ...PrintItem) (*Order, error) {
+	return c.SubmitCustomOrderWithContext(context.Background(), shopId, externalId, address, items...)
+}
+
+/*
+Submit a one-off order printed on the fly. Every variant is checked against the catalog first and
+print areas given as upload ids rather than URLs are resolved to the uploaded image
+*/
+func (c *Client) SubmitCustomOrderWithContext(ctx context.Context, shopId int, externalId string, address Address, items ...PrintItem) (*Order, error) {
+	builder := NewOrderBuilder().ExternalId(externalId).AddressTo(address)
+	for _, item := range items {
+		builder.AddPrintItem(item)
+	}
+	order, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.verifyPrintItems(ctx, order.LineItems); err != nil {
+		return nil, err
+	}
+	if err := c.resolvePrintAreas(ctx, order.LineItems); err != nil {
+		return nil, err
+	}
+	return order, c.SubmitOrderWithContext(ctx, shopId, order)
+}
+
+// verifyPrintItems checks that every variant is offered by the print provider
+// for the blueprint and that it has a placeholder for every print area.
+func (c *Client) verifyPrintItems(ctx context.Context, items []*LineItem) error {
+	catalog := map[[2]int]*PrintProvider{}
+	errs := ValidationErrors{}
+	for i, item := range items {
+		key := [2]int{*item.BlueprintId, *item.PrintProviderId}
+		provider, ok := catalog[key]
+		if !ok {
+			var err error
+			provider, err = c.GetVariantsWithContext(ctx, *item.BlueprintId, *item.PrintProviderId)
+			if err != nil {
+				return err
+			}
+			catalog[key] = provider
+		}
+		variant := findVariant(provider, *item.VariantId)
+		prefix := fmt.Sprintf("line_items[%d]", i)
+		if variant == nil {
+			errs = append(errs, &FieldError{
+				Field:   prefix + ".variant_id",
+				Message: fmt.Sprintf("variant %d is not offered by print provider %d for blueprint %d", *item.VariantId, *item.PrintProviderId, *item.BlueprintId),
+			})
+			continue
+		}
+		for _, position := range sortedPositions(*item.PrintAreas) {
+			if !hasPlaceholder(variant, position) {
+				errs = append(errs, &FieldError{
+					Field:   prefix + ".print_areas." + position,
+					Message: fmt.Sprintf("variant %d cannot be printed on %s", variant.Id, position),
+				})
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func findVariant(provider *PrintProvider, variantId int) *CatalogVariant {
+	for _, variant := range provider.Variants {
+		if variant.Id == variantId {
+			return variant
+		}
+	}
+	return nil
+}
+
+func hasPlaceholder(variant *CatalogVariant, position string) bool {
+	// Variants listed without placeholders cannot be checked
+	if len(variant.Placeholders) == 0 {
+		return true
+	}
+	for _, placeholder := range variant.Placeholders {
+		if placeholder.Position == position {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePrintAreas replaces upload ids with the URL of the uploaded image,
+// Printify only accepts image URLs in print areas.
+func (c *Client) resolvePrintAreas(ctx context.Context, items []*LineItem) error {
+	for _, item := range items {
+		for position, image := range *item.PrintAreas {
+			if isImageURL(image) {
+				continue
+			}
+			upload, err := c.GetUploadWithContext(ctx, image)
+			if err != nil {
+				return err
+			}
+			(*item.PrintAreas)[position] = upload.PreviewUrl
+		}
+	}
+	return nil
+}
+
+func isImageURL(image string) bool {
+	u, err := url.Parse(image)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
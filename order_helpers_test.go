@@ -0,0 +1,82 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func newCustomOrderServer(submitted *Order) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/catalog/blueprints/384/print_providers/1/variants.json":
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{
+				"id": 1,
+				"title": "Monster Digital",
+				"variants": [{
+					"id": 45740,
+					"title": "Heather Grey / S",
+					"options": {"color": "Heather Grey", "size": "S"},
+					"placeholders": [{"position": "front", "height": 3995, "width": 3153}]
+				}]
+			}`))
+		case "/v1/uploads/5e16d66791287a0006e522b2.json":
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": "5e16d66791287a0006e522b2", "preview_url": "https://images.example.com/uploaded.png"}`))
+		case "/v1/shops/1/orders.json":
+			_ = json.NewDecoder(req.Body).Decode(submitted)
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 42}`))
+		default:
+			rw.WriteHeader(404)
+		}
+	}))
+}
+
+func TestClient_SubmitCustomOrder(t *testing.T) {
+	submitted := &Order{}
+	s := newCustomOrderServer(submitted)
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	order, err := client.SubmitCustomOrder(1, "ext-1", testAddress, PrintItem{
+		BlueprintId:     384,
+		PrintProviderId: 1,
+		VariantId:       45740,
+		PrintAreas:      map[string]string{PositionFront: "5e16d66791287a0006e522b2"},
+		Quantity:        1,
+	})
+	if err != nil || order.Id == nil || *order.Id != 42 {
+		fmt.Println(order, err)
+		t.FailNow()
+	}
+	printAreas := map[string]string{"front": "https://images.example.com/uploaded.png"}
+	if len(submitted.LineItems) != 1 || !reflect.DeepEqual(*submitted.LineItems[0].PrintAreas, printAreas) {
+		fmt.Println(submitted.LineItems)
+		t.Fail()
+	}
+}
+
+func TestClient_SubmitCustomOrderUnknownVariant(t *testing.T) {
+	submitted := &Order{}
+	s := newCustomOrderServer(submitted)
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	_, err := client.SubmitCustomOrder(1, "ext-1", testAddress,
+		PrintItem{BlueprintId: 384, PrintProviderId: 1, VariantId: 1, Quantity: 1,
+			PrintAreas: map[string]string{PositionFront: "https://images.example.com/a.png"}},
+		PrintItem{BlueprintId: 384, PrintProviderId: 1, VariantId: 45740, Quantity: 1,
+			PrintAreas: map[string]string{PositionBack: "https://images.example.com/b.png"}},
+	)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 || errs[0].Field != "line_items[0].variant_id" || errs[1].Field != "line_items[1].print_areas.back" {
+		fmt.Println(err)
+		t.Fail()
+	}
+	if submitted.LineItems != nil {
+		t.Fail()
+	}
+}
@@ -0,0 +1,116 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrIllegalTransition = errors.New("printify: illegal order status transition")
+
+type OrderStatus string
+
+const (
+	StatusPending             OrderStatus = "pending"
+	StatusOnHold              OrderStatus = "on-hold"
+	StatusSendingToProduction OrderStatus = "sending-to-production"
+	StatusInProduction        OrderStatus = "in-production"
+	StatusCanceled            OrderStatus = "canceled"
+	StatusFulfilled           OrderStatus = "fulfilled"
+	StatusPartiallyFulfilled  OrderStatus = "partially-fulfilled"
+	StatusHasIssues           OrderStatus = "has-issues"
+)
+
+// orderTransitions lists the statuses an order may move to from each status,
+// canceled and fulfilled orders are final.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	StatusPending:             {StatusOnHold, StatusSendingToProduction, StatusCanceled, StatusHasIssues},
+	StatusOnHold:              {StatusPending, StatusSendingToProduction, StatusCanceled, StatusHasIssues},
+	StatusSendingToProduction: {StatusInProduction, StatusHasIssues},
+	StatusInProduction:        {StatusFulfilled, StatusPartiallyFulfilled, StatusHasIssues},
+	StatusPartiallyFulfilled:  {StatusFulfilled, StatusHasIssues},
+	StatusHasIssues:           {StatusPending, StatusOnHold, StatusSendingToProduction, StatusCanceled},
+	StatusCanceled:            {},
+	StatusFulfilled:           {},
+}
+
+/*
+Valid reports whether the status is one Printify knows about
+*/
+func (s OrderStatus) Valid() bool {
+	_, ok := orderTransitions[s]
+	return ok
+}
+
+/*
+Final reports whether no further transition is possible from the status
+*/
+func (s OrderStatus) Final() bool {
+	next, ok := orderTransitions[s]
+	return ok && len(next) == 0
+}
+
+/*
+CanTransitionTo reports whether an order may move from this status to next
+*/
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+TransitionError is returned when an action is not allowed for the current status of an order, it matches ErrIllegalTransition
+*/
+type TransitionError struct {
+	OrderId int
+	Action  string
+	From    OrderStatus
+	To      OrderStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("printify: cannot %s order %d: status %q cannot change to %q", e.Action, e.OrderId, e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrIllegalTransition
+}
+
+func (o *Order) status() OrderStatus {
+	if o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+/*
+CanCancel reports whether the order may still be canceled
+*/
+func (o *Order) CanCancel() bool {
+	return o.status().CanTransitionTo(StatusCanceled)
+}
+
+/*
+CanSendToProduction reports whether the order may be sent to production
+*/
+func (o *Order) CanSendToProduction() bool {
+	return o.status().CanTransitionTo(StatusSendingToProduction)
+}
+
+// checkTransition fails with a TransitionError unless the order may move to
+// the given status. Orders with a status this package does not know are let
+// through so that Printify has the final say.
+func checkTransition(order *Order, action string, to OrderStatus) error {
+	from := order.status()
+	if !from.Valid() || from.CanTransitionTo(to) {
+		return nil
+	}
+	orderId := 0
+	if order.Id != nil {
+		orderId = *order.Id
+	}
+	return &TransitionError{OrderId: orderId, Action: action, From: from, To: to}
+}
@@ -0,0 +1,92 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOrderStatus_CanTransitionTo(t *testing.T) {
+	cases := []struct {
+		from, to OrderStatus
+		allowed  bool
+	}{
+		{StatusPending, StatusSendingToProduction, true},
+		{StatusOnHold, StatusCanceled, true},
+		{StatusInProduction, StatusFulfilled, true},
+		{StatusInProduction, StatusCanceled, false},
+		{StatusFulfilled, StatusCanceled, false},
+		{StatusCanceled, StatusPending, false},
+	}
+	for _, c := range cases {
+		if c.from.CanTransitionTo(c.to) != c.allowed {
+			fmt.Println(c.from, c.to)
+			t.Fail()
+		}
+	}
+	if !StatusFulfilled.Final() || !StatusCanceled.Final() || StatusPending.Final() {
+		t.Fail()
+	}
+	if OrderStatus("unknown").Valid() {
+		t.Fail()
+	}
+}
+
+func TestClient_CancelOrderIllegalTransition(t *testing.T) {
+	posted := false
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost {
+			posted = true
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"id": 2, "status": "fulfilled", "line_items": []}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	order, err := client.CancelOrder(1, 2)
+	if !errors.Is(err, ErrIllegalTransition) {
+		fmt.Println(err)
+		t.Fail()
+	}
+	var transitionErr *TransitionError
+	if !errors.As(err, &transitionErr) || transitionErr.From != StatusFulfilled || transitionErr.To != StatusCanceled {
+		fmt.Println(transitionErr)
+		t.Fail()
+	}
+	if order == nil || order.CanCancel() {
+		t.Fail()
+	}
+	if posted {
+		fmt.Println("cancel request was sent for a fulfilled order")
+		t.Fail()
+	}
+}
+
+func TestClient_SendOrderToProductionPending(t *testing.T) {
+	var methods []string
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		methods = append(methods, req.Method)
+		rw.WriteHeader(200)
+		if req.Method == http.MethodPost {
+			_, _ = rw.Write([]byte(`{"id": 2, "status": "sending-to-production", "line_items": []}`))
+			return
+		}
+		_, _ = rw.Write([]byte(`{"id": 2, "status": "pending", "line_items": []}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	order, err := client.SendOrderToProduction(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *order.Status != StatusSendingToProduction {
+		fmt.Println(*order.Status)
+		t.Fail()
+	}
+	if len(methods) != 2 || methods[0] != http.MethodGet || methods[1] != http.MethodPost {
+		fmt.Println(methods)
+		t.Fail()
+	}
+}
@@ -1,8 +1,10 @@
 package go_printify
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -15,20 +17,22 @@ const (
 )
 
 type Order struct {
-	Id                       *int               `json:"id,omitempty"`
-	AddressTo                *map[string]string `json:"address_to,omitempty"`
-	LineItems                []*LineItem        `json:"line_items"`
-	Metadata                 *OrderMetadata     `json:"metadata,omitempty"`
-	TotalPrice               *float32           `json:"total_price,omitempty"`
-	TotalShipping            *float32           `json:"total_shipping,omitempty"`
-	TotalTax                 *float32           `json:"total_tax,omitempty"`
-	Status                   *string            `json:"status,omitempty"`
-	ShippingMethod           int                `json:"shipping_method"`
-	SendShippingNotification *bool              `json:"send_shipping_notification"`
-	Shipments                []*Shipment        `json:"shipments,omitempty"`
-	CreatedAt                *time.Time         `json:"created_at,omitempty"`
-	SentToProductionAt       *time.Time         `json:"sent_to_production_at,omitempty"`
-	FulfilledAt              *time.Time         `json:"fulfilled_at,omitempty"`
+	Id                       *int           `json:"id,omitempty"`
+	ExternalId               *string        `json:"external_id,omitempty"`
+	Label                    *string        `json:"label,omitempty"`
+	AddressTo                *Address       `json:"address_to,omitempty"`
+	LineItems                []*LineItem    `json:"line_items"`
+	Metadata                 *OrderMetadata `json:"metadata,omitempty"`
+	TotalPrice               *Money         `json:"total_price,omitempty"`
+	TotalShipping            *Money         `json:"total_shipping,omitempty"`
+	TotalTax                 *Money         `json:"total_tax,omitempty"`
+	Status                   *OrderStatus   `json:"status,omitempty"`
+	ShippingMethod           ShippingMethod `json:"shipping_method"`
+	SendShippingNotification *bool          `json:"send_shipping_notification"`
+	Shipments                []*Shipment    `json:"shipments,omitempty"`
+	CreatedAt                *time.Time     `json:"created_at,omitempty"`
+	SentToProductionAt       *time.Time     `json:"sent_to_production_at,omitempty"`
+	FulfilledAt              *time.Time     `json:"fulfilled_at,omitempty"`
 }
 
 type LineItem struct {
@@ -40,10 +44,10 @@ type LineItem struct {
 	PrintProviderId    *int               `json:"print_provider_id,omitempty"`
 	PrintAreas         *map[string]string `json:"print_areas,omitempty"`
 	PrintDetails       *PrintDetails      `json:"print_details,omitempty"`
-	Cost               *float32           `json:"cost,omitempty"`
+	Cost               *Money             `json:"cost,omitempty"`
 	Sku                *string            `json:"sku,omitempty"`
-	ShippingCost       *float32           `json:"shipping_cost,omitempty"`
-	Status             *string            `json:"status,omitempty"`
+	ShippingCost       *Money             `json:"shipping_cost,omitempty"`
+	Status             *OrderStatus       `json:"status,omitempty"`
 	Metadata           *LineItemMetadata  `json:"metadata,omitempty"`
 	SentToProductionAt *time.Time         `json:"sent_to_production_at,omitempty"`
 	FulfilledAt        *time.Time         `json:"fulfilled_at,omitempty"`
@@ -57,119 +61,288 @@ type OrderMetadata struct {
 }
 
 type LineItemMetadata struct {
-	Title        string  `json:"title"`
-	Price        float32 `json:"price"`
-	VariantLabel string  `json:"variant_label"`
-	Sku          string  `json:"sku"`
-	Country      string  `json:"country"`
+	Title        string `json:"title"`
+	Price        Money  `json:"price"`
+	VariantLabel string `json:"variant_label"`
+	Sku          string `json:"sku"`
+	Country      string `json:"country"`
 }
 
+// Shipment is a tracked parcel of an order, DeliveredAt is nil until it was delivered
 type Shipment struct {
-	Carrier     string    `json:"carrier"`
-	Number      string    `json:"number"`
-	Url         string    `json:"url"`
-	DeliveredAt time.Time `json:"delivered_at"`
+	Carrier     string     `json:"carrier"`
+	Number      string     `json:"number"`
+	Url         string     `json:"url"`
+	DeliveredAt *time.Time `json:"delivered_at"`
 }
 
+type OrderPage struct {
+	Pagination
+	Data []*Order `json:"data"`
+}
+
+// ShippingCost holds the cost of every shipping method, methods not offered for the order cost 0
 type ShippingCost struct {
-	Standard float32 `json:"standard"`
-	Express  float32 `json:"express"`
+	Standard        Money `json:"standard"`
+	Express         Money `json:"express"`
+	Priority        Money `json:"priority"`
+	PrintifyExpress Money `json:"printify_express"`
+	Economy         Money `json:"economy"`
 }
 
 /*
-Retrieve a list of orders
+Cost returns the cost of the given shipping method and whether it is available for the order
 */
-func (c *Client) ListShopOrders(shopId int, page, limit *int, statusFilter *string) ([]*Order, error) {
-	path := fmt.Sprintf(getShopOrdersPath, shopId)
-	if page != nil || limit != nil || statusFilter != nil {
-		path = fmt.Sprintf("%s?", path)
-	}
-	if page != nil {
-		path = fmt.Sprintf("%spage=%d", path, *page)
+func (s *ShippingCost) Cost(method ShippingMethod) (Money, bool) {
+	var cost Money
+	switch method {
+	case ShippingStandard:
+		cost = s.Standard
+	case ShippingPriority:
+		cost = s.Priority
+	case ShippingExpress:
+		cost = s.PrintifyExpress
+		if cost.IsZero() {
+			cost = s.Express
+		}
+	case ShippingEconomy:
+		cost = s.Economy
 	}
+	return cost, cost.Amount > 0
+}
 
-	if limit != nil {
-		path = fmt.Sprintf("%s&limit=%d", path, *limit)
-	}
+type ListShopOrdersRequest struct {
+	ShopId int
+	Page   *int
+	Limit  *int
+	Status *string
+	Orders *OrderPage
+}
 
-	if statusFilter != nil {
-		path = fmt.Sprintf("%s&status=%s", path, *statusFilter)
+func (r *ListShopOrdersRequest) GetOperation() string { return "orders.list" }
+func (r *ListShopOrdersRequest) GetMethod() string    { return http.MethodGet }
+func (r *ListShopOrdersRequest) GetPath() string {
+	params := url.Values{}
+	if r.Status != nil {
+		params.Set("status", *r.Status)
 	}
+	return pagedPath(fmt.Sprintf(getShopOrdersPath, r.ShopId), r.Page, r.Limit, params)
+}
+func (r *ListShopOrdersRequest) GetBody() interface{} { return nil }
+func (r *ListShopOrdersRequest) GetResponseStruct() interface{} {
+	r.Orders = &OrderPage{}
+	return r.Orders
+}
+
+type GetOrderRequest struct {
+	ShopId  int
+	OrderId int
+	Order   *Order
+}
+
+func (r *GetOrderRequest) GetOperation() string { return "orders.get" }
+func (r *GetOrderRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetOrderRequest) GetPath() string      { return fmt.Sprintf(getShopOrderPath, r.ShopId, r.OrderId) }
+func (r *GetOrderRequest) GetBody() interface{} { return nil }
+func (r *GetOrderRequest) GetResponseStruct() interface{} {
+	r.Order = &Order{}
+	return r.Order
+}
+
+// SubmitOrderRequest decodes the created order back into Order
+type SubmitOrderRequest struct {
+	ShopId int
+	Order  *Order
+}
+
+func (r *SubmitOrderRequest) GetOperation() string           { return "orders.submit" }
+func (r *SubmitOrderRequest) GetMethod() string              { return http.MethodPost }
+func (r *SubmitOrderRequest) GetPath() string                { return fmt.Sprintf(getShopOrdersPath, r.ShopId) }
+func (r *SubmitOrderRequest) GetBody() interface{}           { return r.Order }
+func (r *SubmitOrderRequest) GetResponseStruct() interface{} { return r.Order }
+
+type SendOrderToProductionRequest struct {
+	ShopId  int
+	OrderId int
+	Order   *Order
+}
+
+func (r *SendOrderToProductionRequest) GetOperation() string { return "orders.send_to_production" }
+func (r *SendOrderToProductionRequest) GetMethod() string    { return http.MethodPost }
+func (r *SendOrderToProductionRequest) GetPath() string {
+	return fmt.Sprintf(sendOrderToProductionPath, r.ShopId, r.OrderId)
+}
+func (r *SendOrderToProductionRequest) GetBody() interface{} { return nil }
+func (r *SendOrderToProductionRequest) GetResponseStruct() interface{} {
+	r.Order = &Order{}
+	return r.Order
+}
+
+type CalculateShippingCostsRequest struct {
+	ShopId       int
+	Order        *Order
+	ShippingCost *ShippingCost
+}
+
+func (r *CalculateShippingCostsRequest) GetOperation() string { return "orders.calculate_shipping" }
+func (r *CalculateShippingCostsRequest) GetMethod() string    { return http.MethodPost }
+func (r *CalculateShippingCostsRequest) GetPath() string {
+	return fmt.Sprintf(getShippingCostsPath, r.ShopId)
+}
+func (r *CalculateShippingCostsRequest) GetBody() interface{} { return r.Order }
+func (r *CalculateShippingCostsRequest) GetResponseStruct() interface{} {
+	r.ShippingCost = &ShippingCost{}
+	return r.ShippingCost
+}
+
+type CancelOrderRequest struct {
+	ShopId  int
+	OrderId int
+	Order   *Order
+}
+
+func (r *CancelOrderRequest) GetOperation() string { return "orders.cancel" }
+func (r *CancelOrderRequest) GetMethod() string    { return http.MethodPost }
+func (r *CancelOrderRequest) GetPath() string {
+	return fmt.Sprintf(cancelOrderPath, r.ShopId, r.OrderId)
+}
+func (r *CancelOrderRequest) GetBody() interface{} { return nil }
+func (r *CancelOrderRequest) GetResponseStruct() interface{} {
+	r.Order = &Order{}
+	return r.Order
+}
+
+/*
+Retrieve a list of orders
+*/
+func (c *Client) ListShopOrders(shopId int, page, limit *int, statusFilter *string) ([]*Order, error) {
+	return c.ListShopOrdersWithContext(context.Background(), shopId, page, limit, statusFilter)
+}
 
-	req, err := c.newRequest(http.MethodGet, path, nil)
+/*
+Retrieve a list of orders
+*/
+func (c *Client) ListShopOrdersWithContext(ctx context.Context, shopId int, page, limit *int, statusFilter *string) ([]*Order, error) {
+	orders, err := c.ListShopOrdersPageWithContext(ctx, shopId, page, limit, statusFilter)
 	if err != nil {
 		return nil, err
 	}
-	orderList := make([]*Order, 0)
-	_, err = c.do(req, &orderList)
-	return orderList, err
+	return orders.Data, nil
+}
+
+/*
+Retrieve a page of orders along with the pagination metadata
+*/
+func (c *Client) ListShopOrdersPage(shopId int, page, limit *int, statusFilter *string) (*OrderPage, error) {
+	return c.ListShopOrdersPageWithContext(context.Background(), shopId, page, limit, statusFilter)
+}
+
+/*
+Retrieve a page of orders along with the pagination metadata
+*/
+func (c *Client) ListShopOrdersPageWithContext(ctx context.Context, shopId int, page, limit *int, statusFilter *string) (*OrderPage, error) {
+	request := &ListShopOrdersRequest{ShopId: shopId, Page: page, Limit: limit, Status: statusFilter}
+	err := c.Execute(ctx, request)
+	return request.Orders, err
 }
 
 /*
 Get order details by ID
 */
 func (c *Client) GetOrderDetails(shopId, orderId int) (*Order, error) {
-	path := fmt.Sprintf(getShopOrderPath, shopId, orderId)
-	req, err := c.newRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	order := &Order{}
-	_, err = c.do(req, order)
-	return order, err
+	return c.GetOrderDetailsWithContext(context.Background(), shopId, orderId)
+}
+
+/*
+Get order details by ID
+*/
+func (c *Client) GetOrderDetailsWithContext(ctx context.Context, shopId, orderId int) (*Order, error) {
+	request := &GetOrderRequest{ShopId: shopId, OrderId: orderId}
+	err := c.Execute(ctx, request)
+	return request.Order, err
 }
 
 /*
 Submit an order
 */
 func (c *Client) SubmitOrder(shopId int, order *Order) error {
-	path := fmt.Sprintf(getShopOrdersPath, shopId)
-	req, err := c.newRequest(http.MethodPost, path, order)
-	if err != nil {
-		return err
+	return c.SubmitOrderWithContext(context.Background(), shopId, order)
+}
+
+/*
+Submit an order
+*/
+func (c *Client) SubmitOrderWithContext(ctx context.Context, shopId int, order *Order) error {
+	if order.AddressTo != nil {
+		order.AddressTo.Normalize()
+		if err := order.AddressTo.Validate(); err != nil {
+			return withPrefix("address_to", err)
+		}
 	}
-	_, err = c.do(req, order)
-	return err
+	return c.Execute(ctx, &SubmitOrderRequest{ShopId: shopId, Order: order})
 }
 
 /*
-Send an existing order to production
+Send an existing order to production, fails with a TransitionError when its current status does not allow it
 */
 func (c *Client) SendOrderToProduction(shopId, orderId int) (*Order, error) {
-	path := fmt.Sprintf(sendOrderToProductionPath, shopId, orderId)
-	req, err := c.newRequest(http.MethodPost, path, nil)
+	return c.SendOrderToProductionWithContext(context.Background(), shopId, orderId)
+}
+
+/*
+Send an existing order to production, fails with a TransitionError when its current status does not allow it
+*/
+func (c *Client) SendOrderToProductionWithContext(ctx context.Context, shopId, orderId int) (*Order, error) {
+	current, err := c.GetOrderDetailsWithContext(ctx, shopId, orderId)
 	if err != nil {
 		return nil, err
 	}
-	order := &Order{}
-	_, err = c.do(req, order)
-	return order, err
+	if err := checkTransition(current, "send to production", StatusSendingToProduction); err != nil {
+		return current, err
+	}
+	request := &SendOrderToProductionRequest{ShopId: shopId, OrderId: orderId}
+	err = c.Execute(ctx, request)
+	return request.Order, err
 }
 
 /*
 Calculate the shipping cost of an order
 */
 func (c *Client) CalculateShippingCosts(shopId int, order *Order) (*ShippingCost, error) {
-	path := fmt.Sprintf(getShippingCostsPath, shopId)
-	req, err := c.newRequest(http.MethodPost, path, order)
-	if err != nil {
-		return nil, err
+	return c.CalculateShippingCostsWithContext(context.Background(), shopId, order)
+}
+
+/*
+Calculate the shipping cost of an order
+*/
+func (c *Client) CalculateShippingCostsWithContext(ctx context.Context, shopId int, order *Order) (*ShippingCost, error) {
+	if order.AddressTo != nil {
+		order.AddressTo.Normalize()
 	}
-	shippingCost := &ShippingCost{}
-	_, err = c.do(req, shippingCost)
-	return shippingCost, err
+	request := &CalculateShippingCostsRequest{ShopId: shopId, Order: order}
+	err := c.Execute(ctx, request)
+	return request.ShippingCost, err
 }
 
 /*
-Cancel an order
+Cancel an order, fails with a TransitionError when its current status does not allow it
 */
 func (c *Client) CancelOrder(shopId, orderId int) (*Order, error) {
-	path := fmt.Sprintf(cancelOrderPath, shopId, orderId)
-	req, err := c.newRequest(http.MethodPost, path, nil)
+	return c.CancelOrderWithContext(context.Background(), shopId, orderId)
+}
+
+/*
+Cancel an order, fails with a TransitionError when its current status does not allow it
+*/
+func (c *Client) CancelOrderWithContext(ctx context.Context, shopId, orderId int) (*Order, error) {
+	current, err := c.GetOrderDetailsWithContext(ctx, shopId, orderId)
 	if err != nil {
 		return nil, err
 	}
-	order := &Order{}
-	_, err = c.do(req, order)
-	return order, err
+	if err := checkTransition(current, "cancel", StatusCanceled); err != nil {
+		return current, err
+	}
+	request := &CancelOrderRequest{ShopId: shopId, OrderId: orderId}
+	err = c.Execute(ctx, request)
+	return request.Order, err
 }
@@ -0,0 +1,62 @@
+/*
+Package otelprintify adapts an OpenTelemetry tracer to printify.Tracer
+
+	client, err := printify.NewClient(apiKey, printify.WithTracer(otelprintify.NewTracer(otel.Tracer("printify"))))
+*/
+package otelprintify
+
+import (
+	"context"
+	"fmt"
+
+	printify "github.com/omrikiei/go-printify"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+type span struct {
+	span trace.Span
+}
+
+/*
+NewTracer returns a printify.Tracer opening client spans with the given OpenTelemetry tracer
+*/
+func NewTracer(t trace.Tracer) printify.Tracer {
+	return &tracer{tracer: t}
+}
+
+func (t *tracer) Start(ctx context.Context, operation string) (context.Context, printify.Span) {
+	ctx, s := t.tracer.Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{span: s}
+}
+
+func (s *span) SetAttribute(key string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		s.span.SetAttributes(attribute.String(key, v))
+	case int:
+		s.span.SetAttributes(attribute.Int(key, v))
+	case int64:
+		s.span.SetAttributes(attribute.Int64(key, v))
+	case bool:
+		s.span.SetAttributes(attribute.Bool(key, v))
+	case float64:
+		s.span.SetAttributes(attribute.Float64(key, v))
+	default:
+		s.span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+func (s *span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *span) End() {
+	s.span.End()
+}
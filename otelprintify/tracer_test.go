@@ -0,0 +1,108 @@
+package otelprintify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	printify "github.com/omrikiei/go-printify"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTracer() (printify.Tracer, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	return NewTracer(provider.Tracer("printify")), exporter
+}
+
+func TestTracer_Attributes(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	_, span := tracer.Start(context.Background(), "orders.get")
+	span.SetAttribute("string", "value")
+	span.SetAttribute("int", 1)
+	span.SetAttribute("int64", int64(2))
+	span.SetAttribute("bool", true)
+	span.SetAttribute("float64", 1.5)
+	span.SetAttribute("other", time.Second)
+	span.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatal(spans)
+	}
+	if spans[0].Name != "orders.get" || spans[0].SpanKind != trace.SpanKindClient || spans[0].Status.Code != codes.Unset {
+		fmt.Println(spans[0].Name, spans[0].SpanKind, spans[0].Status)
+		t.Fail()
+	}
+	expected := map[attribute.Key]attribute.Value{
+		"string":  attribute.StringValue("value"),
+		"int":     attribute.IntValue(1),
+		"int64":   attribute.Int64Value(2),
+		"bool":    attribute.BoolValue(true),
+		"float64": attribute.Float64Value(1.5),
+		"other":   attribute.StringValue("1s"),
+	}
+	if len(spans[0].Attributes) != len(expected) {
+		fmt.Println(spans[0].Attributes)
+		t.Fail()
+	}
+	for _, kv := range spans[0].Attributes {
+		if value, ok := expected[kv.Key]; !ok || value != kv.Value {
+			fmt.Println(kv.Key, kv.Value.Emit())
+			t.Fail()
+		}
+	}
+}
+
+func TestTracer_RecordError(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	_, span := tracer.Start(context.Background(), "orders.cancel")
+	span.RecordError(errors.New("boom"))
+	span.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatal(spans)
+	}
+	if spans[0].Status.Code != codes.Error || spans[0].Status.Description != "boom" {
+		fmt.Println(spans[0].Status)
+		t.Fail()
+	}
+	if len(spans[0].Events) != 1 || spans[0].Events[0].Name != "exception" {
+		fmt.Println(spans[0].Events)
+		t.Fail()
+	}
+}
+
+func TestTracer_ClientSpans(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+	}))
+	defer s.Close()
+	tracer, exporter := newTestTracer()
+	client, _ := printify.NewClient("bla", printify.WithBaseURL(s.URL), printify.WithTracer(tracer))
+	if _, err := client.GetOrderDetails(1, 2); err == nil {
+		t.Fail()
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "orders.get" || spans[0].Status.Code != codes.Error {
+		t.Fatal(spans)
+	}
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range spans[0].Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+	if attributes[printify.AttributeStatusCode] != attribute.IntValue(404) || attributes[printify.AttributeOrderId] != attribute.IntValue(2) {
+		fmt.Println(spans[0].Attributes)
+		t.Fail()
+	}
+}
@@ -0,0 +1,237 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+/*
+Pagination holds the metadata of Printify's paginated response envelope
+*/
+type Pagination struct {
+	CurrentPage  int     `json:"current_page"`
+	LastPage     int     `json:"last_page"`
+	Total        int     `json:"total"`
+	PerPage      int     `json:"per_page"`
+	From         int     `json:"from"`
+	To           int     `json:"to"`
+	FirstPageUrl string  `json:"first_page_url"`
+	LastPageUrl  string  `json:"last_page_url"`
+	NextPageUrl  *string `json:"next_page_url"`
+	PrevPageUrl  *string `json:"prev_page_url"`
+	Path         string  `json:"path"`
+}
+
+/*
+HasNext reports whether a page follows this one
+*/
+func (p Pagination) HasNext() bool {
+	if p.NextPageUrl != nil && *p.NextPageUrl != "" {
+		return true
+	}
+	return p.CurrentPage < p.LastPage
+}
+
+func pagedPath(path string, page, limit *int, params url.Values) string {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	if page != nil {
+		query.Set("page", strconv.Itoa(*page))
+	}
+	if limit != nil {
+		query.Set("limit", strconv.Itoa(*limit))
+	}
+	if len(query) == 0 {
+		return path
+	}
+	return fmt.Sprintf("%s?%s", path, query.Encode())
+}
+
+// pager holds the page walking state shared by the exported iterators, fetch
+// loads the requested page into the iterator and returns its metadata and size.
+type pager struct {
+	fetch   func(page int) (Pagination, int, error)
+	page    Pagination
+	fetched bool
+	done    bool
+	err     error
+	index   int
+	size    int
+}
+
+func (p *pager) load(page int) bool {
+	meta, size, err := p.fetch(page)
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+	p.page = meta
+	p.fetched = true
+	p.size = size
+	p.index = -1
+	return true
+}
+
+func (p *pager) next() bool {
+	if p.done {
+		return false
+	}
+	if !p.fetched && !p.load(1) {
+		return false
+	}
+	p.index++
+	for p.index >= p.size {
+		if p.size == 0 || !p.page.HasNext() {
+			p.done = true
+			return false
+		}
+		if !p.load(p.page.CurrentPage + 1) {
+			return false
+		}
+		p.index++
+	}
+	return true
+}
+
+/*
+Err returns the error that stopped the iteration, if any
+*/
+func (p *pager) Err() error {
+	return p.err
+}
+
+/*
+Total returns the total number of items reported by Printify, fetching the first page if needed
+*/
+func (p *pager) Total() (int, error) {
+	if !p.fetched && !p.done {
+		p.load(1)
+	}
+	return p.page.Total, p.err
+}
+
+/*
+Stop ends the iteration early, subsequent calls to Next return false
+*/
+func (p *pager) Stop() {
+	p.done = true
+}
+
+/*
+OrderIterator lazily walks every page of a shop's orders
+
+	it := client.IterateShopOrders(ctx, shopId, nil, nil)
+	for it.Next() {
+		fmt.Println(*it.Order().Id)
+	}
+	err := it.Err()
+*/
+type OrderIterator struct {
+	pager
+	orders []*Order
+}
+
+/*
+Next advances to the next order, fetching the following page when needed
+*/
+func (it *OrderIterator) Next() bool {
+	return it.next()
+}
+
+/*
+Order returns the current order
+*/
+func (it *OrderIterator) Order() *Order {
+	if it.index < 0 || it.index >= len(it.orders) {
+		return nil
+	}
+	return it.orders[it.index]
+}
+
+/*
+Iterate over all orders of a shop, limit sets the page size
+*/
+func (c *Client) IterateShopOrders(ctx context.Context, shopId int, limit *int, statusFilter *string) *OrderIterator {
+	it := &OrderIterator{}
+	it.fetch = func(page int) (Pagination, int, error) {
+		orders, err := c.ListShopOrdersPageWithContext(ctx, shopId, &page, limit, statusFilter)
+		if err != nil {
+			return Pagination{}, 0, err
+		}
+		it.orders = orders.Data
+		return orders.Pagination, len(orders.Data), nil
+	}
+	return it
+}
+
+// walkOrdersSince calls fn with the orders of a shop, newest first, until fn
+// returns false. Printify lists orders by descending creation time, so the
+// walk ends with the page holding the first order created before since
+// instead of paging through the shop's entire history. The rest of that
+// page is still walked in case the listing is not strictly ordered, older
+// orders are skipped. A zero since walks every order.
+func (c *Client) walkOrdersSince(ctx context.Context, shopId int, since time.Time, fn func(order *Order) bool) error {
+	it := c.IterateShopOrders(ctx, shopId, nil, nil)
+	reached := false
+	for it.Next() {
+		order := it.Order()
+		if order.CreatedAt != nil && order.CreatedAt.Before(since) {
+			reached = true
+		} else if !fn(order) {
+			it.Stop()
+			break
+		}
+		if reached && it.index == it.size-1 {
+			it.Stop()
+			break
+		}
+	}
+	return it.Err()
+}
+
+/*
+ProductIterator lazily walks every page of a shop's products
+*/
+type ProductIterator struct {
+	pager
+	products []*Product
+}
+
+/*
+Next advances to the next product, fetching the following page when needed
+*/
+func (it *ProductIterator) Next() bool {
+	return it.next()
+}
+
+/*
+Product returns the current product
+*/
+func (it *ProductIterator) Product() *Product {
+	if it.index < 0 || it.index >= len(it.products) {
+		return nil
+	}
+	return it.products[it.index]
+}
+
+/*
+Iterate over all products of a shop, limit sets the page size
+*/
+func (c *Client) IterateProducts(ctx context.Context, shopId int, limit *int) *ProductIterator {
+	it := &ProductIterator{}
+	it.fetch = func(page int) (Pagination, int, error) {
+		products, err := c.GetProductsPageWithContext(ctx, shopId, &page, limit)
+		if err != nil {
+			return Pagination{}, 0, err
+		}
+		it.products = products.Data
+		return products.Pagination, len(products.Data), nil
+	}
+	return it
+}
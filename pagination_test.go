@@ -0,0 +1,109 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newOrderPagesServer(requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		*requests = append(*requests, req.URL.RawQuery)
+		rw.WriteHeader(200)
+		switch req.URL.Query().Get("page") {
+		case "1":
+			_, _ = rw.Write([]byte(`{
+				"current_page": 1,
+				"data": [{"id": 1, "line_items": []}, {"id": 2, "line_items": []}],
+				"last_page": 2,
+				"next_page_url": "/?page=2",
+				"per_page": 2,
+				"total": 3
+			}`))
+		case "2":
+			_, _ = rw.Write([]byte(`{
+				"current_page": 2,
+				"data": [{"id": 3, "line_items": []}],
+				"last_page": 2,
+				"next_page_url": null,
+				"per_page": 2,
+				"total": 3
+			}`))
+		default:
+			rw.WriteHeader(404)
+		}
+	}))
+}
+
+func TestClient_IterateShopOrders(t *testing.T) {
+	requests := make([]string, 0)
+	s := newOrderPagesServer(&requests)
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	limit := 2
+	status := "fulfilled"
+	it := client.IterateShopOrders(context.Background(), 1, &limit, &status)
+	total, err := it.Total()
+	if err != nil || total != 3 {
+		fmt.Println(total, err)
+		t.Fail()
+	}
+	ids := make([]int, 0)
+	for it.Next() {
+		ids = append(ids, *it.Order().Id)
+	}
+	if it.Err() != nil || fmt.Sprint(ids) != "[1 2 3]" {
+		fmt.Println(ids, it.Err())
+		t.Fail()
+	}
+	if fmt.Sprint(requests) != "[limit=2&page=1&status=fulfilled limit=2&page=2&status=fulfilled]" {
+		fmt.Println(requests)
+		t.Fail()
+	}
+}
+
+func TestClient_IterateShopOrdersStop(t *testing.T) {
+	requests := make([]string, 0)
+	s := newOrderPagesServer(&requests)
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	it := client.IterateShopOrders(context.Background(), 1, nil, nil)
+	for it.Next() {
+		if *it.Order().Id == 2 {
+			it.Stop()
+		}
+	}
+	if it.Err() != nil || len(requests) != 1 {
+		fmt.Println(requests, it.Err())
+		t.Fail()
+	}
+}
+
+func TestClient_WalkOrdersSince(t *testing.T) {
+	var pages []string
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		pages = append(pages, req.URL.Query().Get("page"))
+		rw.WriteHeader(200)
+		// The second order is listed out of order
+		_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 2, "data": [
+			{"id": 1, "created_at": "2023-01-03T10:00:00Z", "line_items": []},
+			{"id": 2, "created_at": "2023-01-01T10:00:00Z", "line_items": []},
+			{"id": 3, "created_at": "2023-01-02T10:00:00Z", "line_items": []}
+		]}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	since := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	ids := make([]int, 0)
+	err := client.walkOrdersSince(context.Background(), 1, since, func(order *Order) bool {
+		ids = append(ids, *order.Id)
+		return true
+	})
+	if err != nil || fmt.Sprint(ids) != "[1 3]" || fmt.Sprint(pages) != "[1]" {
+		fmt.Println(ids, pages, err)
+		t.Fail()
+	}
+}
@@ -1,6 +1,7 @@
 package go_printify
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -37,18 +38,23 @@ type Product struct {
 	SalesChannelProperties []string                 `json:"sales_channel_properties"`
 }
 
+type ProductPage struct {
+	Pagination
+	Data []*Product `json:"data"`
+}
+
 type ProductVariant struct {
-	Id          *int    `json:"id"`
-	Sku         string  `json:"sku"`
-	Price       float32 `json:"price"`
-	Cost        float32 `json:"cost"`
-	Title       string  `json:"title"`
-	Grams       int     `json:"grams"`
-	IsEnabled   bool    `json:"is_enabled"`
-	InStock     bool    `json:"in_stock"` // Deprecated
-	IsDefault   bool    `json:"is_default"`
-	IsAvailable bool    `json:"is_available"`
-	Options     []int   `json:"options"`
+	Id          *int   `json:"id"`
+	Sku         string `json:"sku"`
+	Price       Money  `json:"price"`
+	Cost        Money  `json:"cost"`
+	Title       string `json:"title"`
+	Grams       int    `json:"grams"`
+	IsEnabled   bool   `json:"is_enabled"`
+	InStock     bool   `json:"in_stock"` // Deprecated
+	IsDefault   bool   `json:"is_default"`
+	IsAvailable bool   `json:"is_available"`
+	Options     []int  `json:"options"`
 }
 
 type ProductMockUpImage struct {
@@ -97,124 +103,289 @@ type External struct {
 	Handle string `json:"handle"`
 }
 
+type GetProductsRequest struct {
+	ShopId   int
+	Page     *int
+	Limit    *int
+	Products *ProductPage
+}
+
+func (r *GetProductsRequest) GetOperation() string { return "products.list" }
+func (r *GetProductsRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetProductsRequest) GetPath() string {
+	return pagedPath(fmt.Sprintf(productsPath, r.ShopId), r.Page, r.Limit, nil)
+}
+func (r *GetProductsRequest) GetBody() interface{} { return nil }
+func (r *GetProductsRequest) GetResponseStruct() interface{} {
+	r.Products = &ProductPage{}
+	return r.Products
+}
+
+type GetProductRequest struct {
+	ShopId    int
+	ProductId int
+	Product   *Product
+}
+
+func (r *GetProductRequest) GetOperation() string { return "products.get" }
+func (r *GetProductRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetProductRequest) GetPath() string      { return fmt.Sprintf(productPath, r.ShopId, r.ProductId) }
+func (r *GetProductRequest) GetBody() interface{} { return nil }
+func (r *GetProductRequest) GetResponseStruct() interface{} {
+	r.Product = &Product{}
+	return r.Product
+}
+
+// CreateProductRequest decodes the created product back into Product
+type CreateProductRequest struct {
+	ShopId  int
+	Product *Product
+}
+
+func (r *CreateProductRequest) GetOperation() string           { return "products.create" }
+func (r *CreateProductRequest) GetMethod() string              { return http.MethodPost }
+func (r *CreateProductRequest) GetPath() string                { return fmt.Sprintf(productsPath, r.ShopId) }
+func (r *CreateProductRequest) GetBody() interface{}           { return r.Product }
+func (r *CreateProductRequest) GetResponseStruct() interface{} { return r.Product }
+
+// UpdateProductRequest updates the product identified by Product.Id
+type UpdateProductRequest struct {
+	ShopId  int
+	Product *Product
+	Updated *Product
+}
+
+func (r *UpdateProductRequest) GetOperation() string { return "products.update" }
+func (r *UpdateProductRequest) GetMethod() string    { return http.MethodPut }
+func (r *UpdateProductRequest) GetPath() string {
+	productId := 0
+	if r.Product.Id != nil {
+		productId = *r.Product.Id
+	}
+	return fmt.Sprintf(productPath, r.ShopId, productId)
+}
+func (r *UpdateProductRequest) GetBody() interface{} { return r.Product }
+func (r *UpdateProductRequest) GetResponseStruct() interface{} {
+	r.Updated = &Product{}
+	return r.Updated
+}
+
+type DeleteProductRequest struct {
+	ShopId    int
+	ProductId int
+}
+
+func (r *DeleteProductRequest) GetOperation() string { return "products.delete" }
+func (r *DeleteProductRequest) GetMethod() string    { return http.MethodDelete }
+func (r *DeleteProductRequest) GetPath() string {
+	return fmt.Sprintf(productPath, r.ShopId, r.ProductId)
+}
+func (r *DeleteProductRequest) GetBody() interface{}           { return nil }
+func (r *DeleteProductRequest) GetResponseStruct() interface{} { return nil }
+
+type PublishProductRequest struct {
+	ShopId     int
+	ProductId  int
+	Properties PublishingProperties
+}
+
+func (r *PublishProductRequest) GetOperation() string { return "products.publish" }
+func (r *PublishProductRequest) GetMethod() string    { return http.MethodPost }
+func (r *PublishProductRequest) GetPath() string {
+	return fmt.Sprintf(publishProductPath, r.ShopId, r.ProductId)
+}
+func (r *PublishProductRequest) GetBody() interface{}           { return r.Properties }
+func (r *PublishProductRequest) GetResponseStruct() interface{} { return nil }
+
+type SetProductPublishSuccessRequest struct {
+	ShopId    int
+	ProductId int
+	External  External
+}
+
+func (r *SetProductPublishSuccessRequest) GetOperation() string {
+	return "products.publishing_succeeded"
+}
+func (r *SetProductPublishSuccessRequest) GetMethod() string { return http.MethodPost }
+func (r *SetProductPublishSuccessRequest) GetPath() string {
+	return fmt.Sprintf(publishSuccessPath, r.ShopId, r.ProductId)
+}
+func (r *SetProductPublishSuccessRequest) GetBody() interface{}           { return r.External }
+func (r *SetProductPublishSuccessRequest) GetResponseStruct() interface{} { return nil }
+
+type SetProductPublishFailureRequest struct {
+	ShopId    int
+	ProductId int
+	Reason    string
+}
+
+func (r *SetProductPublishFailureRequest) GetOperation() string { return "products.publishing_failed" }
+func (r *SetProductPublishFailureRequest) GetMethod() string    { return http.MethodPost }
+func (r *SetProductPublishFailureRequest) GetPath() string {
+	return fmt.Sprintf(publishFailedPath, r.ShopId, r.ProductId)
+}
+func (r *SetProductPublishFailureRequest) GetBody() interface{} {
+	return map[string]string{"reason": r.Reason}
+}
+func (r *SetProductPublishFailureRequest) GetResponseStruct() interface{} { return nil }
+
+type UnPublishRequest struct {
+	ShopId    int
+	ProductId int
+}
+
+func (r *UnPublishRequest) GetOperation() string           { return "products.unpublish" }
+func (r *UnPublishRequest) GetMethod() string              { return http.MethodPost }
+func (r *UnPublishRequest) GetPath() string                { return fmt.Sprintf(unpublishPath, r.ShopId, r.ProductId) }
+func (r *UnPublishRequest) GetBody() interface{}           { return nil }
+func (r *UnPublishRequest) GetResponseStruct() interface{} { return nil }
+
 /*
 Retrieve a list of products
 */
 func (c *Client) GetProducts(shopId int, page *int) ([]*Product, error) {
-	path := fmt.Sprintf(productsPath, shopId)
-	if page != nil {
-		path = fmt.Sprintf("%s?page=%d", path, &page)
-	}
-	req, err := c.newRequest(http.MethodGet, path, nil)
+	return c.GetProductsWithContext(context.Background(), shopId, page)
+}
+
+/*
+Retrieve a list of products
+*/
+func (c *Client) GetProductsWithContext(ctx context.Context, shopId int, page *int) ([]*Product, error) {
+	products, err := c.GetProductsPageWithContext(ctx, shopId, page, nil)
 	if err != nil {
 		return nil, err
 	}
-	products := make([]*Product, 0)
-	_, err = c.do(req, &products)
-	return products, err
+	return products.Data, nil
+}
+
+/*
+Retrieve a page of products along with the pagination metadata
+*/
+func (c *Client) GetProductsPage(shopId int, page, limit *int) (*ProductPage, error) {
+	return c.GetProductsPageWithContext(context.Background(), shopId, page, limit)
+}
+
+/*
+Retrieve a page of products along with the pagination metadata
+*/
+func (c *Client) GetProductsPageWithContext(ctx context.Context, shopId int, page, limit *int) (*ProductPage, error) {
+	request := &GetProductsRequest{ShopId: shopId, Page: page, Limit: limit}
+	err := c.Execute(ctx, request)
+	return request.Products, err
 }
 
 /*
 Retrieve a product
 */
 func (c *Client) GetProduct(shopId, productId int) (*Product, error) {
-	path := fmt.Sprintf(productPath, shopId, productId)
-	req, err := c.newRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	product := &Product{}
-	_, err = c.do(req, product)
-	return product, err
+	return c.GetProductWithContext(context.Background(), shopId, productId)
+}
+
+/*
+Retrieve a product
+*/
+func (c *Client) GetProductWithContext(ctx context.Context, shopId, productId int) (*Product, error) {
+	request := &GetProductRequest{ShopId: shopId, ProductId: productId}
+	err := c.Execute(ctx, request)
+	return request.Product, err
 }
 
 /*
 Create a new product
 */
 func (c *Client) CreateProduct(product Product) error {
-	req, err := c.newRequest(http.MethodPost, productsPath, product)
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, product)
-	return err
+	return c.CreateProductWithContext(context.Background(), product)
+}
+
+/*
+Create a new product
+*/
+func (c *Client) CreateProductWithContext(ctx context.Context, product Product) error {
+	return c.Execute(ctx, &CreateProductRequest{ShopId: product.ShopId, Product: &product})
 }
 
 /*
 Update a product
 */
 func (c *Client) UpdateProduct(shopId int, product Product) (*Product, error) {
-	path := fmt.Sprintf(productPath, shopId, product.Id)
-	req, err := c.newRequest(http.MethodPut, path, product)
-	if err != nil {
-		return nil, err
-	}
-	updatedProduct := &Product{}
-	_, err = c.do(req, updatedProduct)
-	return updatedProduct, err
+	return c.UpdateProductWithContext(context.Background(), shopId, product)
+}
+
+/*
+Update a product
+*/
+func (c *Client) UpdateProductWithContext(ctx context.Context, shopId int, product Product) (*Product, error) {
+	request := &UpdateProductRequest{ShopId: shopId, Product: &product}
+	err := c.Execute(ctx, request)
+	return request.Updated, err
 }
 
 /*
 Delete a product
 */
 func (c *Client) DeleteProduct(shopId int, productId int) error {
-	path := fmt.Sprintf(productPath, shopId, productId)
-	req, err := c.newRequest(http.MethodDelete, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, nil)
-	return err
+	return c.DeleteProductWithContext(context.Background(), shopId, productId)
+}
+
+/*
+Delete a product
+*/
+func (c *Client) DeleteProductWithContext(ctx context.Context, shopId int, productId int) error {
+	return c.Execute(ctx, &DeleteProductRequest{ShopId: shopId, ProductId: productId})
 }
 
 /*
 Publish a product
 */
 func (c *Client) PublishProduct(shopId, productId int, publishProperties PublishingProperties) error {
-	path := fmt.Sprintf(publishProductPath, shopId, productId)
-	req, err := c.newRequest(http.MethodPost, path, publishProperties)
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, nil)
-	return err
+	return c.PublishProductWithContext(context.Background(), shopId, productId, publishProperties)
+}
+
+/*
+Publish a product
+*/
+func (c *Client) PublishProductWithContext(ctx context.Context, shopId, productId int, publishProperties PublishingProperties) error {
+	return c.Execute(ctx, &PublishProductRequest{ShopId: shopId, ProductId: productId, Properties: publishProperties})
 }
 
 /*
 Set product publish status to succeeded
 */
 func (c *Client) SetProductPublishSuccess(shopId, productId int, external External) error {
-	path := fmt.Sprintf(publishSuccessPath, shopId, productId)
-	req, err := c.newRequest(http.MethodPost, path, external)
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, nil)
-	return err
+	return c.SetProductPublishSuccessWithContext(context.Background(), shopId, productId, external)
+}
+
+/*
+Set product publish status to succeeded
+*/
+func (c *Client) SetProductPublishSuccessWithContext(ctx context.Context, shopId, productId int, external External) error {
+	return c.Execute(ctx, &SetProductPublishSuccessRequest{ShopId: shopId, ProductId: productId, External: external})
 }
 
 /*
 Set product publish status to failed
 */
 func (c *Client) SetProductPublishFailre(shopId, productId int, reason string) error {
-	path := fmt.Sprintf(publishFailedPath, shopId, productId)
-	req, err := c.newRequest(http.MethodPost, path, map[string]string{"reason": reason})
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, nil)
-	return err
+	return c.SetProductPublishFailreWithContext(context.Background(), shopId, productId, reason)
+}
+
+/*
+Set product publish status to failed
+*/
+func (c *Client) SetProductPublishFailreWithContext(ctx context.Context, shopId, productId int, reason string) error {
+	return c.Execute(ctx, &SetProductPublishFailureRequest{ShopId: shopId, ProductId: productId, Reason: reason})
 }
 
 /*
 Notify that a product has been unpublished
 */
 func (c *Client) UnPublish(shopId, productId int) error {
-	path := fmt.Sprintf(unpublishPath, shopId, productId)
-	req, err := c.newRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, nil)
-	return err
+	return c.UnPublishWithContext(context.Background(), shopId, productId)
+}
+
+/*
+Notify that a product has been unpublished
+*/
+func (c *Client) UnPublishWithContext(ctx context.Context, shopId, productId int) error {
+	return c.Execute(ctx, &UnPublishRequest{ShopId: shopId, ProductId: productId})
 }
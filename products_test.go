@@ -0,0 +1,46 @@
+package go_printify
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetProducts(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/shops/7/products.json" || req.URL.Query().Get("page") != "2" {
+			rw.WriteHeader(404)
+			return
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{
+			"current_page": 2,
+			"data": [{"id": 11, "title": "Mug"}],
+			"first_page_url": "/?page=1",
+			"from": 2,
+			"last_page": 2,
+			"last_page_url": "/?page=2",
+			"next_page_url": null,
+			"path": "/v1/shops/7/products.json",
+			"per_page": 1,
+			"prev_page_url": "/?page=1",
+			"to": 2,
+			"total": 2
+		}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	page := 2
+	products, err := client.GetProducts(7, &page)
+	if err != nil || len(products) != 1 || products[0].Title != "Mug" {
+		fmt.Println(products, err)
+		t.Fail()
+	}
+	productPage, err := client.GetProductsPage(7, &page, nil)
+	if err != nil || productPage.CurrentPage != 2 || productPage.LastPage != 2 || productPage.Total != 2 ||
+		productPage.HasNext() || len(productPage.Data) != 1 {
+		fmt.Println(productPage, err)
+		t.Fail()
+	}
+}
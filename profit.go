@@ -0,0 +1,337 @@
+package go_printify
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+/*
+Profit holds the amounts of an order, product or print provider.
+Margin is the revenue left after production cost, shipping and tax.
+*/
+type Profit struct {
+	Revenue        Money `json:"revenue"`
+	ProductionCost Money `json:"production_cost"`
+	Shipping       Money `json:"shipping"`
+	Tax            Money `json:"tax"`
+	Margin         Money `json:"margin"`
+}
+
+func (p *Profit) add(other Profit) error {
+	var err error
+	if p.Revenue, err = p.Revenue.Add(other.Revenue); err != nil {
+		return err
+	}
+	if p.ProductionCost, err = p.ProductionCost.Add(other.ProductionCost); err != nil {
+		return err
+	}
+	if p.Shipping, err = p.Shipping.Add(other.Shipping); err != nil {
+		return err
+	}
+	if p.Tax, err = p.Tax.Add(other.Tax); err != nil {
+		return err
+	}
+	p.Margin, err = p.Margin.Add(other.Margin)
+	return err
+}
+
+func (p *Profit) computeMargin() error {
+	margin := p.Revenue
+	for _, amount := range []Money{p.ProductionCost, p.Shipping, p.Tax} {
+		var err error
+		if margin, err = margin.Sub(amount); err != nil {
+			return err
+		}
+	}
+	p.Margin = margin
+	return nil
+}
+
+type OrderProfit struct {
+	Profit
+	OrderId    int       `json:"order_id"`
+	ExternalId string    `json:"external_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	Quantity   int       `json:"quantity"`
+}
+
+/*
+ProductProfit holds the line items of a single product, line items ordered by sku without a product id are grouped by sku
+*/
+type ProductProfit struct {
+	Profit
+	ProductId string `json:"product_id,omitempty"`
+	Sku       string `json:"sku,omitempty"`
+	Title     string `json:"title"`
+	Quantity  int    `json:"quantity"`
+}
+
+type ProviderProfit struct {
+	Profit
+	PrintProviderId int `json:"print_provider_id"`
+	Quantity        int `json:"quantity"`
+}
+
+/*
+ProfitReport breaks down what a shop earned on its orders. Revenue is the retail price of each line item
+(LineItemMetadata.Price times its quantity), production cost and shipping are what Printify charged for the
+line items and tax is the order's TotalTax. Canceled orders are left out.
+
+Order shipping and tax are split across products and print providers in proportion to their line items'
+revenue, so that every breakdown adds up to Total.
+*/
+type ProfitReport struct {
+	ShopId    int               `json:"shop_id"`
+	Total     Profit            `json:"total"`
+	Quantity  int               `json:"quantity"`
+	Orders    []*OrderProfit    `json:"orders"`
+	Products  []*ProductProfit  `json:"products"`
+	Providers []*ProviderProfit `json:"providers"`
+}
+
+/*
+Build a profit report from the given orders of a shop
+*/
+func NewProfitReport(shopId int, orders []*Order) (*ProfitReport, error) {
+	report := &ProfitReport{ShopId: shopId, Orders: []*OrderProfit{}}
+	products := map[string]*ProductProfit{}
+	providers := map[int]*ProviderProfit{}
+	for _, order := range orders {
+		if order.status() == StatusCanceled {
+			continue
+		}
+		lines, err := lineProfits(order)
+		if err != nil {
+			return nil, err
+		}
+		orderProfit := &OrderProfit{}
+		if order.Id != nil {
+			orderProfit.OrderId = *order.Id
+		}
+		if order.ExternalId != nil {
+			orderProfit.ExternalId = *order.ExternalId
+		}
+		if order.CreatedAt != nil {
+			orderProfit.CreatedAt = *order.CreatedAt
+		}
+		for i, item := range order.LineItems {
+			orderProfit.Quantity += item.Quantity
+			if err := orderProfit.add(lines[i]); err != nil {
+				return nil, err
+			}
+			key, product := productKey(item)
+			if products[key] == nil {
+				products[key] = product
+			}
+			products[key].Quantity += item.Quantity
+			if err := products[key].add(lines[i]); err != nil {
+				return nil, err
+			}
+			providerId := 0
+			if item.PrintProviderId != nil {
+				providerId = *item.PrintProviderId
+			}
+			if providers[providerId] == nil {
+				providers[providerId] = &ProviderProfit{PrintProviderId: providerId}
+			}
+			providers[providerId].Quantity += item.Quantity
+			if err := providers[providerId].add(lines[i]); err != nil {
+				return nil, err
+			}
+		}
+		if err := report.Total.add(orderProfit.Profit); err != nil {
+			return nil, err
+		}
+		report.Quantity += orderProfit.Quantity
+		report.Orders = append(report.Orders, orderProfit)
+	}
+	report.Products = make([]*ProductProfit, 0, len(products))
+	for _, product := range products {
+		report.Products = append(report.Products, product)
+	}
+	sort.Slice(report.Products, func(i, j int) bool {
+		a, b := report.Products[i], report.Products[j]
+		if a.ProductId != b.ProductId {
+			return a.ProductId < b.ProductId
+		}
+		return a.Sku < b.Sku
+	})
+	report.Providers = make([]*ProviderProfit, 0, len(providers))
+	for _, provider := range providers {
+		report.Providers = append(report.Providers, provider)
+	}
+	sort.Slice(report.Providers, func(i, j int) bool {
+		return report.Providers[i].PrintProviderId < report.Providers[j].PrintProviderId
+	})
+	return report, nil
+}
+
+func productKey(item *LineItem) (string, *ProductProfit) {
+	product := &ProductProfit{}
+	if item.Metadata != nil {
+		product.Title = item.Metadata.Title
+	}
+	if item.ProductId != nil && *item.ProductId != "" {
+		product.ProductId = *item.ProductId
+		return "product:" + product.ProductId, product
+	}
+	if item.Sku != nil {
+		product.Sku = *item.Sku
+	} else if item.Metadata != nil {
+		product.Sku = item.Metadata.Sku
+	}
+	return "sku:" + product.Sku, product
+}
+
+// lineProfits returns the profit of every line item of an order. The order's
+// tax and any shipping not reported per line item are allocated to the line
+// items by revenue.
+func lineProfits(order *Order) ([]Profit, error) {
+	lines := make([]Profit, len(order.LineItems))
+	weights := make([]int64, len(order.LineItems))
+	var lineShipping Money
+	for i, item := range order.LineItems {
+		if item.Metadata != nil {
+			lines[i].Revenue = item.Metadata.Price.Mul(int64(item.Quantity))
+		}
+		if item.Cost != nil {
+			lines[i].ProductionCost = *item.Cost
+		}
+		if item.ShippingCost != nil {
+			lines[i].Shipping = *item.ShippingCost
+		}
+		var err error
+		if lineShipping, err = lineShipping.Add(lines[i].Shipping); err != nil {
+			return nil, err
+		}
+		weights[i] = lines[i].Revenue.Amount
+	}
+	if order.TotalShipping != nil {
+		unallocated, err := order.TotalShipping.Sub(lineShipping)
+		if err != nil {
+			return nil, err
+		}
+		for i, share := range allocate(unallocated, weights) {
+			if lines[i].Shipping, err = lines[i].Shipping.Add(share); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if order.TotalTax != nil {
+		for i, share := range allocate(*order.TotalTax, weights) {
+			lines[i].Tax = share
+		}
+	}
+	for i := range lines {
+		if err := lines[i].computeMargin(); err != nil {
+			return nil, err
+		}
+	}
+	return lines, nil
+}
+
+// allocate splits an amount in proportion to the weights, evenly when they
+// are all zero, the remainder of the division goes to the last share so that
+// the shares add up to the amount.
+func allocate(amount Money, weights []int64) []Money {
+	shares := make([]Money, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+	var allocated int64
+	for i, weight := range weights {
+		share := amount.Amount / int64(len(weights))
+		if total > 0 {
+			share = amount.Amount * weight / total
+		}
+		shares[i] = Money{Amount: share, Currency: amount.Currency}
+		allocated += share
+	}
+	shares[len(shares)-1].Amount += amount.Amount - allocated
+	return shares
+}
+
+/*
+Build a profit report of the orders created in [since, until), a zero until includes every order created after since
+*/
+func (c *Client) GetProfitReport(shopId int, since, until time.Time) (*ProfitReport, error) {
+	return c.GetProfitReportWithContext(context.Background(), shopId, since, until)
+}
+
+/*
+Build a profit report of the orders created in [since, until), a zero until includes every order created after since
+*/
+func (c *Client) GetProfitReportWithContext(ctx context.Context, shopId int, since, until time.Time) (*ProfitReport, error) {
+	orders, err := c.ordersCreatedBetween(ctx, shopId, since, until)
+	if err != nil {
+		return nil, err
+	}
+	return NewProfitReport(shopId, orders)
+}
+
+// ordersCreatedBetween lists the orders of a shop created in [since, until),
+// a zero until includes every order created after since.
+func (c *Client) ordersCreatedBetween(ctx context.Context, shopId int, since, until time.Time) ([]*Order, error) {
+	var orders []*Order
+	err := c.walkOrdersSince(ctx, shopId, since, func(order *Order) bool {
+		if order.CreatedAt != nil && (until.IsZero() || order.CreatedAt.Before(until)) {
+			orders = append(orders, order)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+/*
+WriteJSON writes the report as JSON, amounts are integers of minor units as in the Printify API
+*/
+func (r *ProfitReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+/*
+WriteCSV writes a row per order, product and print provider followed by the total, amounts are in major units.
+The level column tells the rows apart and id holds the order, product or print provider id.
+*/
+func (r *ProfitReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	rows := [][]string{{"level", "id", "name", "quantity", "revenue", "production_cost", "shipping", "tax", "margin"}}
+	row := func(level, id, name string, quantity int, p Profit) []string {
+		return []string{
+			level, id, name, strconv.Itoa(quantity),
+			p.Revenue.Decimal(), p.ProductionCost.Decimal(), p.Shipping.Decimal(), p.Tax.Decimal(), p.Margin.Decimal(),
+		}
+	}
+	for _, order := range r.Orders {
+		rows = append(rows, row("order", strconv.Itoa(order.OrderId), order.ExternalId, order.Quantity, order.Profit))
+	}
+	for _, product := range r.Products {
+		id := product.ProductId
+		if id == "" {
+			id = product.Sku
+		}
+		rows = append(rows, row("product", id, product.Title, product.Quantity, product.Profit))
+	}
+	for _, provider := range r.Providers {
+		rows = append(rows, row("provider", strconv.Itoa(provider.PrintProviderId), "", provider.Quantity, provider.Profit))
+	}
+	rows = append(rows, row("total", "", "", r.Quantity, r.Total))
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
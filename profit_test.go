@@ -0,0 +1,89 @@
+package go_printify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_GetProfitReport(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "data": [
+			{"id": 3, "status": "canceled", "created_at": "2023-01-03T00:00:00Z", "line_items": [
+				{"product_id": "p1", "print_provider_id": 1, "quantity": 1, "cost": 1000, "metadata": {"title": "Tee", "price": 2500}}
+			]},
+			{"id": 2, "external_id": "ext-2", "status": "fulfilled", "created_at": "2023-01-02T00:00:00Z",
+				"total_shipping": 600, "total_tax": 301, "line_items": [
+				{"product_id": "p1", "print_provider_id": 1, "quantity": 2, "cost": 2000, "shipping_cost": 400,
+					"metadata": {"title": "Tee", "price": 2000}},
+				{"sku": "MUG", "print_provider_id": 2, "quantity": 1, "cost": 500, "metadata": {"title": "Mug", "price": 1000}}
+			]},
+			{"id": 1, "created_at": "2022-12-31T00:00:00Z", "line_items": []}
+		]}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	report, err := client.GetProfitReport(1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Revenue 5000, cost 2500, shipping 600, tax 301
+	expected := Profit{
+		Revenue:        Money{Amount: 5000},
+		ProductionCost: Money{Amount: 2500},
+		Shipping:       Money{Amount: 600},
+		Tax:            Money{Amount: 301},
+		Margin:         Money{Amount: 1599},
+	}
+	if report.Total != expected || len(report.Orders) != 1 || report.Orders[0].Profit != expected ||
+		report.Orders[0].ExternalId != "ext-2" || report.Orders[0].Quantity != 3 || report.Quantity != 3 {
+		fmt.Println(report.Total, report.Orders)
+		t.Fail()
+	}
+	if len(report.Products) != 2 || len(report.Providers) != 2 {
+		t.Fatal(report.Products, report.Providers)
+	}
+	// The 200 of shipping not reported per line item and the tax are split 4:1 by revenue
+	tee, mug := report.Products[1], report.Products[0]
+	if tee.ProductId != "p1" || tee.Quantity != 2 || tee.Shipping.Amount != 560 || tee.Tax.Amount != 240 || tee.Margin.Amount != 1200 {
+		fmt.Println(tee)
+		t.Fail()
+	}
+	if mug.Sku != "MUG" || mug.Shipping.Amount != 40 || mug.Tax.Amount != 61 || mug.Margin.Amount != 399 {
+		fmt.Println(mug)
+		t.Fail()
+	}
+	if report.Providers[0].PrintProviderId != 1 || report.Providers[0].Profit != tee.Profit {
+		fmt.Println(report.Providers[0])
+		t.Fail()
+	}
+
+	csvOut := &bytes.Buffer{}
+	if err := report.WriteCSV(csvOut); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	if len(lines) != 7 || lines[1] != "order,2,ext-2,3,50.00,25.00,6.00,3.01,15.99" || lines[6] != "total,,,3,50.00,25.00,6.00,3.01,15.99" {
+		fmt.Println(csvOut.String())
+		t.Fail()
+	}
+
+	jsonOut := &bytes.Buffer{}
+	if err := report.WriteJSON(jsonOut); err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if total := decoded["total"].(map[string]interface{}); total["margin"] != float64(1599) {
+		fmt.Println(jsonOut.String())
+		t.Fail()
+	}
+}
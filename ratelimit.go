@@ -0,0 +1,170 @@
+package go_printify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrRateLimitExceeded = errors.New("printify: client-side rate limit exceeded")
+
+/*
+RateLimit describes a quota of Requests per time period, Burst requests may be sent back to back
+*/
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+/*
+RateLimitMode selects what happens when a request exceeds the quota
+*/
+type RateLimitMode int
+
+const (
+	// RateLimitWait blocks until a token is available or the request context is done
+	RateLimitWait RateLimitMode = iota
+	// RateLimitFail returns ErrRateLimitExceeded immediately
+	RateLimitFail
+)
+
+var (
+	// Printify's global quota, shared by every endpoint
+	DefaultGlobalRateLimit = RateLimit{Requests: 600, Per: time.Minute, Burst: 600}
+	// Printify's quota for publishing products
+	DefaultPublishRateLimit = RateLimit{Requests: 200, Per: 30 * time.Minute, Burst: 200}
+)
+
+/*
+RateLimiter is a client-side token bucket limiter, safe for use by multiple goroutines sharing a Client
+*/
+type RateLimiter struct {
+	Mode    RateLimitMode
+	global  *tokenBucket
+	publish *tokenBucket
+}
+
+/*
+Create a rate limiter with the given global and publishing quotas
+*/
+func NewRateLimiter(global, publish RateLimit, mode RateLimitMode) (*RateLimiter, error) {
+	globalBucket, err := newTokenBucket(global)
+	if err != nil {
+		return nil, err
+	}
+	publishBucket, err := newTokenBucket(publish)
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimiter{
+		Mode:    mode,
+		global:  globalBucket,
+		publish: publishBucket,
+	}, nil
+}
+
+/*
+Create a blocking rate limiter matching Printify's published quotas
+*/
+func DefaultRateLimiter() *RateLimiter {
+	limiter, _ := NewRateLimiter(DefaultGlobalRateLimit, DefaultPublishRateLimit, RateLimitWait)
+	return limiter
+}
+
+// wait takes a token for the request from every bucket it is subject to,
+// returning how long the request was held back. A publishing token is
+// returned when the global bucket refuses the request.
+func (l *RateLimiter) wait(req *http.Request) (time.Duration, error) {
+	if l == nil {
+		return 0, nil
+	}
+	var waited time.Duration
+	if isPublishRequest(req) {
+		delay, err := l.publish.take(req.Context(), l.Mode)
+		if err != nil {
+			return waited, err
+		}
+		waited += delay
+	}
+	delay, err := l.global.take(req.Context(), l.Mode)
+	if err != nil && isPublishRequest(req) {
+		l.publish.refund()
+	}
+	return waited + delay, err
+}
+
+func isPublishRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/publish.json")
+}
+
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64 // tokens per second
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(limit RateLimit) (*tokenBucket, error) {
+	if limit.Requests <= 0 || limit.Per <= 0 {
+		return nil, errors.New("printify: rate limit requires positive Requests and Per")
+	}
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:     float64(limit.Requests) / limit.Per.Seconds(),
+		capacity: float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}, nil
+}
+
+// take reserves a token, sleeping until it becomes available in RateLimitWait
+// mode. The reservation is returned to the bucket if ctx is done first.
+func (b *tokenBucket) take(ctx context.Context, mode RateLimitMode) (time.Duration, error) {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		b.mu.Unlock()
+		return 0, nil
+	}
+	if mode == RateLimitFail {
+		b.mu.Unlock()
+		return 0, ErrRateLimitExceeded
+	}
+	delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		b.mu.Unlock()
+		return 0, ErrRateLimitExceeded
+	}
+	b.tokens--
+	b.mu.Unlock()
+
+	if err := sleep(ctx, delay); err != nil {
+		b.refund()
+		return 0, err
+	}
+	return delay, nil
+}
+
+// refund returns a token taken for a request that was not sent.
+func (b *tokenBucket) refund() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+}
@@ -0,0 +1,122 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_RateLimiterFailMode(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	limiter, err := NewRateLimiter(
+		RateLimit{Requests: 10, Per: time.Hour, Burst: 3},
+		RateLimit{Requests: 1, Per: time.Hour, Burst: 1},
+		RateLimitFail,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RateLimiter = limiter
+
+	if err := client.PublishProduct(1, 1, PublishingProperties{}); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+	if err := client.PublishProduct(1, 2, PublishingProperties{}); err != ErrRateLimitExceeded {
+		fmt.Println(err)
+		t.Fail()
+	}
+	if _, err := client.GetOrderDetails(1, 1); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+	if _, err := client.GetOrderDetails(1, 1); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+	if _, err := client.GetOrderDetails(1, 1); err != ErrRateLimitExceeded {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
+
+func TestClient_RateLimiterRefundsPublishToken(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	limiter, _ := NewRateLimiter(
+		RateLimit{Requests: 1, Per: 50 * time.Millisecond, Burst: 1},
+		RateLimit{Requests: 1, Per: time.Hour, Burst: 1},
+		RateLimitFail,
+	)
+	client.RateLimiter = limiter
+
+	if _, err := client.GetOrderDetails(1, 1); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+	// The global bucket is empty, the publishing token must not be spent
+	if err := client.PublishProduct(1, 1, PublishingProperties{}); err != ErrRateLimitExceeded {
+		fmt.Println(err)
+		t.Fail()
+	}
+	time.Sleep(60 * time.Millisecond)
+	if err := client.PublishProduct(1, 1, PublishingProperties{}); err != nil {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
+
+func TestClient_RateLimiterWaitMode(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	limiter, _ := NewRateLimiter(
+		RateLimit{Requests: 1, Per: 20 * time.Millisecond, Burst: 1},
+		DefaultPublishRateLimit,
+		RateLimitWait,
+	)
+	client.RateLimiter = limiter
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetOrderDetails(1, 1); err != nil {
+			fmt.Println(err)
+			t.Fail()
+		}
+	}
+	if time.Since(start) < 30*time.Millisecond {
+		t.Fail()
+	}
+
+	limiter, _ = NewRateLimiter(
+		RateLimit{Requests: 1, Per: time.Hour, Burst: 1},
+		DefaultPublishRateLimit,
+		RateLimitWait,
+	)
+	client.RateLimiter = limiter
+	_, _ = client.GetOrderDetails(1, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := client.GetOrderDetailsWithContext(ctx, 1, 1); err != context.Canceled {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
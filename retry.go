@@ -0,0 +1,209 @@
+package go_printify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+/*
+RetryPolicy controls how failed requests are retried by the Client
+*/
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled on every subsequent one
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff; a Retry-After header takes precedence
+	MaxDelay time.Duration
+	// Jitter randomly shortens each delay by up to this fraction (0 to 1)
+	Jitter float64
+	// RetryableStatusCodes lists the response status codes worth retrying
+	RetryableStatusCodes []int
+	// RetryableError decides whether a transport error is worth retrying,
+	// by default every error which is not a context cancellation is retried
+	RetryableError func(err error) bool
+	// RetryNonIdempotent allows retrying POST requests such as SubmitOrder,
+	// which may result in duplicates if the first attempt reached Printify
+	RetryNonIdempotent bool
+}
+
+/*
+RetryError is returned when a transport error persisted after more than one attempt
+*/
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("printify: giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+/*
+Create a retry policy suitable for most workloads: 3 attempts with exponential backoff on 429 and 5xx responses
+*/
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 1 {
+		return errors.New("printify: retry policy MaxAttempts must be at least 1")
+	}
+	if p.BaseDelay < 0 || p.MaxDelay < 0 {
+		return errors.New("printify: retry policy delays must not be negative")
+	}
+	if p.MaxDelay > 0 && p.BaseDelay > p.MaxDelay {
+		return errors.New("printify: retry policy BaseDelay exceeds MaxDelay")
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return errors.New("printify: retry policy Jitter must be between 0 and 1")
+	}
+	return nil
+}
+
+type noRetriesKey struct{}
+
+// withoutRetries makes requests sent with the returned context attempt only
+// once, for callers retrying on their own terms.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetriesKey{}, true)
+}
+
+// shouldRetry reports whether the outcome of the given attempt is worth
+// retrying and how long to wait before doing so.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || err == nil {
+		return 0, false
+	}
+	if disabled, _ := req.Context().Value(noRetriesKey{}).(bool); disabled {
+		return 0, false
+	}
+	if req.Context().Err() != nil {
+		return 0, false
+	}
+	if !isIdempotent(req.Method) && !p.RetryNonIdempotent {
+		return 0, false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return 0, false
+	}
+	apiErr := &APIError{}
+	if errors.As(err, &apiErr) {
+		if !p.retryableStatus(apiErr.StatusCode) {
+			return 0, false
+		}
+		if resp != nil {
+			if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+				return delay, true
+			}
+		}
+		return p.backoff(attempt), true
+	}
+	// A response was received but could not be decoded, sending the request
+	// again will not help.
+	if resp != nil {
+		return 0, false
+	}
+	if p.RetryableError != nil && !p.RetryableError(err) {
+		return 0, false
+	}
+	return p.backoff(attempt), true
+}
+
+func (p *RetryPolicy) retryableStatus(status int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+	return delay
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		delay := time.Until(at)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewind prepares a request to be sent again by replacing its consumed body.
+func rewind(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
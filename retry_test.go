@@ -0,0 +1,85 @@
+package go_printify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(s *httptest.Server) *Client {
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.BaseDelay = time.Millisecond
+	client.RetryPolicy.MaxDelay = 5 * time.Millisecond
+	return client
+}
+
+func TestClient_RetryTransientErrors(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			rw.WriteHeader(503)
+		case 2:
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(429)
+		default:
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`[{"id": 1, "title": "Shop", "sales_channel": "api"}]`))
+		}
+	}))
+	client := newRetryTestClient(s)
+	defer s.Close()
+	shops, err := client.ListShops()
+	if err != nil || len(shops) != 1 || calls != 3 {
+		fmt.Println(err, shops, calls)
+		t.Fail()
+	}
+}
+
+func TestClient_RetryGivesUp(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		rw.WriteHeader(502)
+	}))
+	client := newRetryTestClient(s)
+	defer s.Close()
+	_, err := client.ListShops()
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) || apiErr.Attempts != 3 || calls != 3 {
+		fmt.Println(err, calls)
+		t.Fail()
+	}
+}
+
+func TestClient_RetrySkipsNonIdempotent(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			rw.WriteHeader(503)
+			return
+		}
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"id": 5}`))
+	}))
+	client := newRetryTestClient(s)
+	defer s.Close()
+	err := client.SubmitOrder(1, &Order{})
+	if err == nil || calls != 1 {
+		fmt.Println(err, calls)
+		t.Fail()
+	}
+
+	client.RetryPolicy.RetryNonIdempotent = true
+	order := &Order{}
+	err = client.SubmitOrder(1, order)
+	if err != nil || calls != 3 || order.Id == nil || *order.Id != 5 {
+		fmt.Println(err, calls)
+		t.Fail()
+	}
+}
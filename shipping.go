@@ -0,0 +1,123 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const submitExpressOrderPath = "shops/%d/orders/express.json"
+
+type ShippingMethod int
+
+const (
+	ShippingStandard ShippingMethod = 1
+	ShippingPriority ShippingMethod = 2
+	ShippingExpress  ShippingMethod = 3
+	ShippingEconomy  ShippingMethod = 4
+)
+
+func (m ShippingMethod) String() string {
+	switch m {
+	case ShippingStandard:
+		return "standard"
+	case ShippingPriority:
+		return "priority"
+	case ShippingExpress:
+		return "express"
+	case ShippingEconomy:
+		return "economy"
+	}
+	return fmt.Sprintf("ShippingMethod(%d)", int(m))
+}
+
+/*
+Valid reports whether the method is one Printify knows about
+*/
+func (m ShippingMethod) Valid() bool {
+	return m >= ShippingStandard && m <= ShippingEconomy
+}
+
+/*
+ExpressOrder is one of the orders created by SubmitExpressOrder, Type is either express or regular
+*/
+type ExpressOrder struct {
+	Type      string      `json:"type"`
+	Id        *int        `json:"id"`
+	LineItems []*LineItem `json:"line_items"`
+}
+
+/*
+ExpressOrderResult lists the orders Printify split an express submission into
+*/
+type ExpressOrderResult struct {
+	Orders []*ExpressOrder
+}
+
+/*
+Express returns the order holding the line items that qualified for express shipping, if any
+*/
+func (r *ExpressOrderResult) Express() *ExpressOrder {
+	return r.ofType("express")
+}
+
+/*
+Regular returns the order holding the line items that did not qualify for express shipping, if any
+*/
+func (r *ExpressOrderResult) Regular() *ExpressOrder {
+	return r.ofType("regular")
+}
+
+func (r *ExpressOrderResult) ofType(orderType string) *ExpressOrder {
+	for _, order := range r.Orders {
+		if order.Type == orderType {
+			return order
+		}
+	}
+	return nil
+}
+
+type SubmitExpressOrderRequest struct {
+	ShopId int
+	Order  *Order
+	Result *ExpressOrderResult
+}
+
+func (r *SubmitExpressOrderRequest) GetOperation() string { return "orders.submit_express" }
+func (r *SubmitExpressOrderRequest) GetMethod() string    { return http.MethodPost }
+func (r *SubmitExpressOrderRequest) GetPath() string {
+	return fmt.Sprintf(submitExpressOrderPath, r.ShopId)
+}
+func (r *SubmitExpressOrderRequest) GetBody() interface{} { return r.Order }
+func (r *SubmitExpressOrderRequest) GetResponseStruct() interface{} {
+	r.Result = &ExpressOrderResult{Orders: make([]*ExpressOrder, 0)}
+	return &r.Result.Orders
+}
+
+/*
+Submit a Printify Express order, line items which do not qualify for express shipping are split into a regular order
+*/
+func (c *Client) SubmitExpressOrder(shopId int, order *Order) (*ExpressOrderResult, error) {
+	return c.SubmitExpressOrderWithContext(context.Background(), shopId, order)
+}
+
+/*
+Submit a Printify Express order, line items which do not qualify for express shipping are split into a regular order
+*/
+func (c *Client) SubmitExpressOrderWithContext(ctx context.Context, shopId int, order *Order) (*ExpressOrderResult, error) {
+	if order.AddressTo != nil {
+		order.AddressTo.Normalize()
+		if err := order.AddressTo.Validate(); err != nil {
+			return nil, withPrefix("address_to", err)
+		}
+	}
+	if order.ShippingMethod == 0 {
+		order.ShippingMethod = ShippingExpress
+	}
+	if order.ShippingMethod != ShippingExpress {
+		return nil, ValidationErrors{{Field: "shipping_method", Message: fmt.Sprintf("must be %s for an express order, got %s", ShippingExpress, order.ShippingMethod)}}
+	}
+	request := &SubmitExpressOrderRequest{ShopId: shopId, Order: order}
+	err := c.Execute(ctx, request)
+	return request.Result, err
+}
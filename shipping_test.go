@@ -0,0 +1,71 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_CalculateShippingCosts(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"standard": 1000, "express": 0, "priority": 2500, "printify_express": 799, "economy": 399}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	address := testAddress
+	costs, err := client.CalculateShippingCosts(1, &Order{AddressTo: &address})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[ShippingMethod]Money{
+		ShippingStandard: {Amount: 1000},
+		ShippingPriority: {Amount: 2500},
+		ShippingExpress:  {Amount: 799},
+		ShippingEconomy:  {Amount: 399},
+	}
+	for method, cost := range expected {
+		if actual, ok := costs.Cost(method); !ok || actual != cost {
+			fmt.Println(method, actual)
+			t.Fail()
+		}
+	}
+}
+
+func TestClient_SubmitExpressOrder(t *testing.T) {
+	var submitted map[string]interface{}
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/shops/1/orders/express.json" {
+			rw.WriteHeader(404)
+			return
+		}
+		_ = json.NewDecoder(req.Body).Decode(&submitted)
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`[
+			{"type": "express", "id": 1, "line_items": [{"sku": "FAST", "quantity": 1}]},
+			{"type": "regular", "id": 2, "line_items": [{"sku": "SLOW", "quantity": 1}]}
+		]`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	order, _ := NewOrderBuilder().AddressTo(testAddress).AddSkuItem("FAST", 1).AddSkuItem("SLOW", 1).Build()
+	result, err := client.SubmitExpressOrder(1, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submitted["shipping_method"] != float64(3) {
+		fmt.Println(submitted)
+		t.Fail()
+	}
+	if *result.Express().Id != 1 || *result.Express().LineItems[0].Sku != "FAST" || *result.Regular().LineItems[0].Sku != "SLOW" {
+		fmt.Println(result)
+		t.Fail()
+	}
+
+	order.ShippingMethod = ShippingEconomy
+	if _, err := client.SubmitExpressOrder(1, order); err == nil {
+		t.Fail()
+	}
+}
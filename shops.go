@@ -1,6 +1,7 @@
 package go_printify
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -16,28 +17,55 @@ type Shop struct {
 	SalesChannel string `json:"sales_channel"`
 }
 
+type ListShopsRequest struct {
+	Shops []*Shop
+}
+
+func (r *ListShopsRequest) GetOperation() string { return "shops.list" }
+func (r *ListShopsRequest) GetMethod() string    { return http.MethodGet }
+func (r *ListShopsRequest) GetPath() string      { return shopsPath }
+func (r *ListShopsRequest) GetBody() interface{} { return nil }
+func (r *ListShopsRequest) GetResponseStruct() interface{} {
+	r.Shops = make([]*Shop, 0)
+	return &r.Shops
+}
+
+type DeleteShopRequest struct {
+	ShopId int
+}
+
+func (r *DeleteShopRequest) GetOperation() string           { return "shops.disconnect" }
+func (r *DeleteShopRequest) GetMethod() string              { return http.MethodDelete }
+func (r *DeleteShopRequest) GetPath() string                { return fmt.Sprintf(disconnectShopPath, r.ShopId) }
+func (r *DeleteShopRequest) GetBody() interface{}           { return nil }
+func (r *DeleteShopRequest) GetResponseStruct() interface{} { return nil }
+
 /*
 Retrieve list of shops in a Printify account
 */
 func (c *Client) ListShops() ([]*Shop, error) {
-	req, err := c.newRequest(http.MethodGet, shopsPath, nil)
-	if err != nil {
-		return nil, err
-	}
-	fmt.Println(req)
-	shopList := make([]*Shop, 0)
-	_, err = c.do(req, &shopList)
-	return shopList, err
+	return c.ListShopsWithContext(context.Background())
+}
+
+/*
+Retrieve list of shops in a Printify account
+*/
+func (c *Client) ListShopsWithContext(ctx context.Context) ([]*Shop, error) {
+	request := &ListShopsRequest{}
+	err := c.Execute(ctx, request)
+	return request.Shops, err
 }
 
 /*
 Disconnect a shop
 */
 func (c *Client) DeleteShop(Id int) error {
-	req, err := c.newRequest(http.MethodDelete, fmt.Sprintf(disconnectShopPath, Id), nil)
-	if err != nil {
-		return err
-	}
-	_, err = c.do(req, &struct{}{})
-	return err
+	return c.DeleteShopWithContext(context.Background(), Id)
+}
+
+/*
+Disconnect a shop
+*/
+func (c *Client) DeleteShopWithContext(ctx context.Context, Id int) error {
+	return c.Execute(ctx, &DeleteShopRequest{ShopId: Id})
 }
@@ -1,10 +1,10 @@
 package go_printify
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"reflect"
 	"testing"
 )
@@ -39,9 +39,7 @@ func TestClient_ListShops(t *testing.T) {
 		]
 		`))
 	}))
-	serverUrl, _ := url.Parse(s.URL)
-	client := NewClient("bla")
-	client.BaseURL = serverUrl
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
 	defer s.Close()
 	shopsRes, err := client.ListShops()
 	if err != nil {
@@ -57,3 +55,19 @@ func TestClient_ListShops(t *testing.T) {
 func TestClient_DeleteShop(t *testing.T) {
 	// nothing to test here really
 }
+
+func TestClient_ListShopsWithContext_Canceled(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`[]`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.ListShopsWithContext(ctx)
+	if err != context.Canceled {
+		fmt.Println(err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Package tracetest provides an in-memory printify.Tracer for tests
+*/
+package tracetest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	printify "github.com/omrikiei/go-printify"
+)
+
+/*
+Recorder is a printify.Tracer keeping every span it opened in memory
+*/
+type Recorder struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+/*
+Span is a span recorded by a Recorder
+*/
+type Span struct {
+	mu         sync.Mutex
+	Operation  string
+	Attributes map[string]interface{}
+	Errors     []error
+	StartedAt  time.Time
+	EndedAt    time.Time
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Start(ctx context.Context, operation string) (context.Context, printify.Span) {
+	span := &Span{
+		Operation:  operation,
+		Attributes: map[string]interface{}{},
+		StartedAt:  time.Now(),
+	}
+	r.mu.Lock()
+	r.spans = append(r.spans, span)
+	r.mu.Unlock()
+	return ctx, span
+}
+
+/*
+Spans returns the spans recorded so far, in the order they were started
+*/
+func (r *Recorder) Spans() []*Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	spans := make([]*Span, len(r.spans))
+	copy(spans, r.spans)
+	return spans
+}
+
+/*
+Reset forgets every recorded span
+*/
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	r.spans = nil
+	r.mu.Unlock()
+}
+
+func (s *Span) SetAttribute(key string, value interface{}) {
+	s.mu.Lock()
+	s.Attributes[key] = value
+	s.mu.Unlock()
+}
+
+func (s *Span) RecordError(err error) {
+	s.mu.Lock()
+	s.Errors = append(s.Errors, err)
+	s.mu.Unlock()
+}
+
+func (s *Span) End() {
+	s.mu.Lock()
+	s.EndedAt = time.Now()
+	s.mu.Unlock()
+}
+
+/*
+Ended reports whether End was called
+*/
+func (s *Span) Ended() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.EndedAt.IsZero()
+}
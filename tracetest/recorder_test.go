@@ -0,0 +1,61 @@
+package tracetest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	printify "github.com/omrikiei/go-printify"
+)
+
+func TestRecorder_ClientSpans(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/catalog/blueprints/3/print_providers/99/variants.json":
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 99, "title": "Provider", "variants": []}`))
+			return
+		case "/v1/shops/1/orders/2.json":
+			rw.WriteHeader(200)
+			_, _ = rw.Write([]byte(`{"id": 2, "status": "pending", "line_items": []}`))
+			return
+		}
+		rw.WriteHeader(404)
+	}))
+	defer s.Close()
+	recorder := NewRecorder()
+	client, _ := printify.NewClient("bla", printify.WithBaseURL(s.URL), printify.WithTracer(recorder))
+	_, _ = client.GetVariants(3, 99)
+	_, _ = client.SendOrderToProduction(1, 2)
+
+	spans := recorder.Spans()
+	if len(spans) != 3 {
+		t.Fatal(spans)
+	}
+	expected := map[string]interface{}{
+		printify.AttributeMethod:     http.MethodGet,
+		printify.AttributePath:       "/v1/catalog/blueprints/3/print_providers/99/variants.json",
+		printify.AttributeStatusCode: 200,
+		printify.AttributeRetryCount: 0,
+		"printify.blueprint_id":      3,
+		"printify.print_provider_id": 99,
+	}
+	if spans[0].Operation != "catalog.get_variants" || !reflect.DeepEqual(spans[0].Attributes, expected) ||
+		len(spans[0].Errors) != 0 || !spans[0].Ended() {
+		fmt.Println(spans[0])
+		t.Fail()
+	}
+	// The order status is checked before sending it to production
+	if spans[1].Operation != "orders.get" || spans[1].Attributes[printify.AttributeStatusCode] != 200 {
+		fmt.Println(spans[1])
+		t.Fail()
+	}
+	if spans[2].Operation != "orders.send_to_production" || spans[2].Attributes[printify.AttributeShopId] != 1 ||
+		spans[2].Attributes[printify.AttributeOrderId] != 2 || spans[2].Attributes[printify.AttributeStatusCode] != 404 ||
+		len(spans[2].Errors) != 1 {
+		fmt.Println(spans[2])
+		t.Fail()
+	}
+}
@@ -0,0 +1,102 @@
+package go_printify
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+)
+
+/*
+Tracer opens a span for every API operation, e.g. catalog.get_variants.
+It is small enough to be backed by OpenTelemetry, see the otelprintify module, or by tracetest in tests.
+*/
+type Tracer interface {
+	Start(ctx context.Context, operation string) (context.Context, Span)
+}
+
+/*
+Span is a single traced API operation
+*/
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// Attribute keys set on every span
+const (
+	AttributeMethod     = "http.method"
+	AttributePath       = "http.path"
+	AttributeStatusCode = "http.status_code"
+	AttributeRetryCount = "printify.retry_count"
+	AttributeShopId     = "printify.shop_id"
+	AttributeOrderId    = "printify.order_id"
+	AttributeProductId  = "printify.product_id"
+)
+
+// spanFields maps request struct fields to the span attribute they are recorded as
+var spanFields = map[string]string{
+	"ShopId":      AttributeShopId,
+	"OrderId":     AttributeOrderId,
+	"ProductId":   AttributeProductId,
+	"BlueprintId": "printify.blueprint_id",
+	"ProviderId":  "printify.print_provider_id",
+	"UploadId":    "printify.upload_id",
+	"WebhookId":   "printify.webhook_id",
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) RecordError(error)                {}
+func (noopSpan) End()                             {}
+
+func (c *Client) startSpan(ctx context.Context, request ApiRequest) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	ctx, span := c.tracer.Start(ctx, Operation(ctx))
+	span.SetAttribute(AttributeMethod, request.GetMethod())
+	setRequestAttributes(span, request)
+	return ctx, span
+}
+
+// setRequestAttributes records the identifiers of the request types declared
+// in this package, which all follow the ShopId/OrderId/... naming.
+func setRequestAttributes(span Span, request ApiRequest) {
+	v := reflect.ValueOf(request)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for field, attribute := range spanFields {
+		value := v.FieldByName(field)
+		if !value.IsValid() {
+			continue
+		}
+		switch value.Kind() {
+		case reflect.Int:
+			span.SetAttribute(attribute, int(value.Int()))
+		case reflect.String:
+			span.SetAttribute(attribute, value.String())
+		}
+	}
+}
+
+func endSpan(span Span, req *http.Request, resp *http.Response, attempts int, err error) {
+	if req != nil {
+		span.SetAttribute(AttributePath, req.URL.Path)
+	}
+	if resp != nil {
+		span.SetAttribute(AttributeStatusCode, resp.StatusCode)
+	}
+	if attempts > 0 {
+		span.SetAttribute(AttributeRetryCount, attempts-1)
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
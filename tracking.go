@@ -0,0 +1,248 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Carrier string
+
+const (
+	CarrierUSPS          Carrier = "usps"
+	CarrierUPS           Carrier = "ups"
+	CarrierFedEx         Carrier = "fedex"
+	CarrierDHL           Carrier = "dhl"
+	CarrierDHLECommerce  Carrier = "dhl_ecommerce"
+	CarrierCanadaPost    Carrier = "canada_post"
+	CarrierRoyalMail     Carrier = "royal_mail"
+	CarrierAustraliaPost Carrier = "australia_post"
+	CarrierDPD           Carrier = "dpd"
+)
+
+// Carrier names as they are spelled by print providers, lower cased and
+// stripped of spaces and punctuation.
+var carrierAliases = map[string]Carrier{
+	"usps":                      CarrierUSPS,
+	"unitedstatespostalservice": CarrierUSPS,
+	"ups":                       CarrierUPS,
+	"unitedparcelservice":       CarrierUPS,
+	"fedex":                     CarrierFedEx,
+	"federalexpress":            CarrierFedEx,
+	"dhl":                       CarrierDHL,
+	"dhlexpress":                CarrierDHL,
+	"dhlecommerce":              CarrierDHLECommerce,
+	"dhlglobalmail":             CarrierDHLECommerce,
+	"canadapost":                CarrierCanadaPost,
+	"postescanada":              CarrierCanadaPost,
+	"royalmail":                 CarrierRoyalMail,
+	"australiapost":             CarrierAustraliaPost,
+	"auspost":                   CarrierAustraliaPost,
+	"dpd":                       CarrierDPD,
+}
+
+// Tracking page of each carrier, %s is replaced with the tracking number
+var trackingUrls = map[Carrier]string{
+	CarrierUSPS:          "https://tools.usps.com/go/TrackConfirmAction?tLabels=%s",
+	CarrierUPS:           "https://www.ups.com/track?tracknum=%s",
+	CarrierFedEx:         "https://www.fedex.com/fedextrack/?trknbr=%s",
+	CarrierDHL:           "https://www.dhl.com/en/express/tracking.html?AWB=%s",
+	CarrierDHLECommerce:  "https://webtrack.dhlglobalmail.com/?trackingnumber=%s",
+	CarrierCanadaPost:    "https://www.canadapost-postescanada.ca/track-reperage/en#/search?searchFor=%s",
+	CarrierRoyalMail:     "https://www.royalmail.com/track-your-item#/tracking-results/%s",
+	CarrierAustraliaPost: "https://auspost.com.au/mypost/track/#/details/%s",
+	CarrierDPD:           "https://tracking.dpd.de/status/en_US/parcel/%s",
+}
+
+/*
+NormalizeCarrier maps a carrier name to its known code, it reports false for carriers this package does not know
+*/
+func NormalizeCarrier(name string) (Carrier, bool) {
+	key := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '.':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+	carrier, ok := carrierAliases[key]
+	return carrier, ok
+}
+
+/*
+TrackingUrl returns the carrier's tracking page for the given tracking number, or an empty string for unknown carriers
+*/
+func (c Carrier) TrackingUrl(number string) string {
+	format, ok := trackingUrls[c]
+	if !ok || number == "" {
+		return ""
+	}
+	return fmt.Sprintf(format, url.QueryEscape(number))
+}
+
+/*
+Delivered reports whether the shipment was delivered
+*/
+func (s *Shipment) Delivered() bool {
+	return s.DeliveredAt != nil && !s.DeliveredAt.IsZero()
+}
+
+/*
+Normalize replaces the carrier name with its known code and builds the tracking url when it is missing
+*/
+func (s *Shipment) Normalize() {
+	carrier, ok := NormalizeCarrier(s.Carrier)
+	if !ok {
+		return
+	}
+	s.Carrier = string(carrier)
+	if s.Url == "" {
+		s.Url = carrier.TrackingUrl(s.Number)
+	}
+}
+
+/*
+DurationStats summarises a set of durations, percentiles use the nearest rank
+*/
+type DurationStats struct {
+	Count  int
+	Min    time.Duration
+	Max    time.Duration
+	Mean   time.Duration
+	Median time.Duration
+	P90    time.Duration
+}
+
+func newDurationStats(durations []time.Duration) DurationStats {
+	if len(durations) == 0 {
+		return DurationStats{}
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	return DurationStats{
+		Count:  len(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   total / time.Duration(len(sorted)),
+		Median: percentile(sorted, 50),
+		P90:    percentile(sorted, 90),
+	}
+}
+
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+/*
+CarrierStats holds the shipments of a single carrier and how long they took to be delivered after fulfillment
+*/
+type CarrierStats struct {
+	Shipments     int
+	Delivered     int
+	TimeToDeliver DurationStats
+}
+
+/*
+DeliveryReport summarises how fast a shop's orders were shipped and delivered:
+TimeToProduction runs from CreatedAt to SentToProductionAt, TimeToShip from CreatedAt to FulfilledAt
+and TimeToDeliver from FulfilledAt to the delivery of the order's last shipment.
+Orders missing a timestamp are left out of the durations that need it.
+*/
+type DeliveryReport struct {
+	ShopId           int
+	Orders           int
+	Shipped          int
+	Delivered        int
+	TimeToProduction DurationStats
+	TimeToShip       DurationStats
+	TimeToDeliver    DurationStats
+	Carriers         map[Carrier]*CarrierStats
+}
+
+/*
+Build a delivery report from the given orders of a shop, carriers are normalized and unknown ones are reported under their name
+*/
+func NewDeliveryReport(shopId int, orders []*Order) *DeliveryReport {
+	report := &DeliveryReport{ShopId: shopId, Carriers: map[Carrier]*CarrierStats{}}
+	var toProduction, toShip, toDeliver []time.Duration
+	carrierDurations := map[Carrier][]time.Duration{}
+	for _, order := range orders {
+		report.Orders++
+		if order.CreatedAt != nil && order.SentToProductionAt != nil {
+			toProduction = append(toProduction, order.SentToProductionAt.Sub(*order.CreatedAt))
+		}
+		if order.FulfilledAt != nil {
+			report.Shipped++
+			if order.CreatedAt != nil {
+				toShip = append(toShip, order.FulfilledAt.Sub(*order.CreatedAt))
+			}
+		}
+		var lastDelivery *time.Time
+		delivered := len(order.Shipments) > 0
+		for _, shipment := range order.Shipments {
+			carrier, ok := NormalizeCarrier(shipment.Carrier)
+			if !ok {
+				carrier = Carrier(shipment.Carrier)
+			}
+			stats, ok := report.Carriers[carrier]
+			if !ok {
+				stats = &CarrierStats{}
+				report.Carriers[carrier] = stats
+			}
+			stats.Shipments++
+			if !shipment.Delivered() {
+				delivered = false
+				continue
+			}
+			stats.Delivered++
+			if order.FulfilledAt != nil {
+				carrierDurations[carrier] = append(carrierDurations[carrier], shipment.DeliveredAt.Sub(*order.FulfilledAt))
+			}
+			if lastDelivery == nil || shipment.DeliveredAt.After(*lastDelivery) {
+				lastDelivery = shipment.DeliveredAt
+			}
+		}
+		if delivered {
+			report.Delivered++
+			if order.FulfilledAt != nil {
+				toDeliver = append(toDeliver, lastDelivery.Sub(*order.FulfilledAt))
+			}
+		}
+	}
+	report.TimeToProduction = newDurationStats(toProduction)
+	report.TimeToShip = newDurationStats(toShip)
+	report.TimeToDeliver = newDurationStats(toDeliver)
+	for carrier, durations := range carrierDurations {
+		report.Carriers[carrier].TimeToDeliver = newDurationStats(durations)
+	}
+	return report
+}
+
+/*
+Build a delivery report of the orders created in [since, until), a zero until includes every order created after since
+*/
+func (c *Client) GetDeliveryReport(shopId int, since, until time.Time) (*DeliveryReport, error) {
+	return c.GetDeliveryReportWithContext(context.Background(), shopId, since, until)
+}
+
+/*
+Build a delivery report of the orders created in [since, until), a zero until includes every order created after since
+*/
+func (c *Client) GetDeliveryReportWithContext(ctx context.Context, shopId int, since, until time.Time) (*DeliveryReport, error) {
+	orders, err := c.ordersCreatedBetween(ctx, shopId, since, until)
+	if err != nil {
+		return nil, err
+	}
+	return NewDeliveryReport(shopId, orders), nil
+}
@@ -0,0 +1,76 @@
+package go_printify
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestShipment_Normalize(t *testing.T) {
+	shipment := &Shipment{Carrier: "United States Postal Service", Number: "9400 1"}
+	shipment.Normalize()
+	if shipment.Carrier != "usps" || shipment.Url != "https://tools.usps.com/go/TrackConfirmAction?tLabels=9400+1" {
+		fmt.Println(shipment)
+		t.Fail()
+	}
+	existing := &Shipment{Carrier: "DHL-eCommerce", Number: "1", Url: "https://example.com/1"}
+	existing.Normalize()
+	if existing.Carrier != "dhl_ecommerce" || existing.Url != "https://example.com/1" {
+		fmt.Println(existing)
+		t.Fail()
+	}
+	unknown := &Shipment{Carrier: "Local Courier", Number: "1"}
+	unknown.Normalize()
+	if unknown.Carrier != "Local Courier" || unknown.Url != "" || unknown.Delivered() {
+		fmt.Println(unknown)
+		t.Fail()
+	}
+}
+
+func TestClient_GetDeliveryReport(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{"current_page": 1, "last_page": 1, "data": [
+			{"id": 4, "created_at": "2023-01-10T00:00:00Z", "line_items": []},
+			{"id": 3, "created_at": "2023-01-03T00:00:00Z", "sent_to_production_at": "2023-01-03T12:00:00Z",
+				"fulfilled_at": "2023-01-06T00:00:00Z", "line_items": [],
+				"shipments": [{"carrier": "UPS", "number": "1Z", "delivered_at": null}]},
+			{"id": 2, "created_at": "2023-01-02T00:00:00Z", "sent_to_production_at": "2023-01-02T06:00:00Z",
+				"fulfilled_at": "2023-01-04T00:00:00Z", "line_items": [],
+				"shipments": [
+					{"carrier": "usps", "number": "1", "delivered_at": "2023-01-06T00:00:00Z"},
+					{"carrier": "usps", "number": "2", "delivered_at": "2023-01-08T00:00:00Z"}
+				]},
+			{"id": 1, "created_at": "2022-12-31T00:00:00Z", "line_items": []}
+		]}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC)
+	report, err := client.GetDeliveryReport(1, since, until)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Orders != 2 || report.Shipped != 2 || report.Delivered != 1 {
+		fmt.Println(report)
+		t.Fail()
+	}
+	day := 24 * time.Hour
+	if report.TimeToProduction.Count != 2 || report.TimeToProduction.Mean != 9*time.Hour ||
+		report.TimeToShip.Min != 2*day || report.TimeToShip.Max != 3*day || report.TimeToDeliver.Median != 4*day {
+		fmt.Println(report.TimeToProduction, report.TimeToShip, report.TimeToDeliver)
+		t.Fail()
+	}
+	usps := report.Carriers[CarrierUSPS]
+	if usps == nil || usps.Shipments != 2 || usps.Delivered != 2 || usps.TimeToDeliver.Min != 2*day || usps.TimeToDeliver.P90 != 4*day {
+		fmt.Println(usps)
+		t.Fail()
+	}
+	if ups := report.Carriers[CarrierUPS]; ups == nil || ups.Shipments != 1 || ups.Delivered != 0 {
+		fmt.Println(ups)
+		t.Fail()
+	}
+}
@@ -0,0 +1,178 @@
+package go_printify
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	uploadsPath       = "uploads.json"
+	uploadPath        = "uploads/%s.json"
+	uploadImagePath   = "uploads/images.json"
+	archiveUploadPath = "uploads/%s/archive.json"
+)
+
+type Upload struct {
+	Id         string `json:"id"`
+	FileName   string `json:"file_name"`
+	Height     int    `json:"height"`
+	Width      int    `json:"width"`
+	Size       int    `json:"size"`
+	MimeType   string `json:"mime_type"`
+	PreviewUrl string `json:"preview_url"`
+	UploadTime string `json:"upload_time"`
+}
+
+type UploadPage struct {
+	Pagination
+	Data []*Upload `json:"data"`
+}
+
+type ListUploadsRequest struct {
+	Page    *int
+	Limit   *int
+	Uploads *UploadPage
+}
+
+func (r *ListUploadsRequest) GetOperation() string { return "uploads.list" }
+func (r *ListUploadsRequest) GetMethod() string    { return http.MethodGet }
+func (r *ListUploadsRequest) GetPath() string      { return pagedPath(uploadsPath, r.Page, r.Limit, nil) }
+func (r *ListUploadsRequest) GetBody() interface{} { return nil }
+func (r *ListUploadsRequest) GetResponseStruct() interface{} {
+	r.Uploads = &UploadPage{}
+	return r.Uploads
+}
+
+type GetUploadRequest struct {
+	UploadId string
+	Upload   *Upload
+}
+
+func (r *GetUploadRequest) GetOperation() string { return "uploads.get" }
+func (r *GetUploadRequest) GetMethod() string    { return http.MethodGet }
+func (r *GetUploadRequest) GetPath() string      { return fmt.Sprintf(uploadPath, r.UploadId) }
+func (r *GetUploadRequest) GetBody() interface{} { return nil }
+func (r *GetUploadRequest) GetResponseStruct() interface{} {
+	r.Upload = &Upload{}
+	return r.Upload
+}
+
+// UploadImageRequest uploads either from Url or from base64 encoded Contents
+type UploadImageRequest struct {
+	FileName string
+	Url      string
+	Contents string
+	Upload   *Upload
+}
+
+type uploadImage struct {
+	FileName string `json:"file_name"`
+	Url      string `json:"url,omitempty"`
+	Contents string `json:"contents,omitempty"`
+}
+
+func (r *UploadImageRequest) GetOperation() string { return "uploads.upload_image" }
+func (r *UploadImageRequest) GetMethod() string    { return http.MethodPost }
+func (r *UploadImageRequest) GetPath() string      { return uploadImagePath }
+func (r *UploadImageRequest) GetBody() interface{} {
+	return &uploadImage{FileName: r.FileName, Url: r.Url, Contents: r.Contents}
+}
+func (r *UploadImageRequest) GetResponseStruct() interface{} {
+	r.Upload = &Upload{}
+	return r.Upload
+}
+
+type ArchiveUploadRequest struct {
+	UploadId string
+}
+
+func (r *ArchiveUploadRequest) GetOperation() string           { return "uploads.archive" }
+func (r *ArchiveUploadRequest) GetMethod() string              { return http.MethodPost }
+func (r *ArchiveUploadRequest) GetPath() string                { return fmt.Sprintf(archiveUploadPath, r.UploadId) }
+func (r *ArchiveUploadRequest) GetBody() interface{}           { return nil }
+func (r *ArchiveUploadRequest) GetResponseStruct() interface{} { return nil }
+
+/*
+Retrieve a list of uploaded images
+*/
+func (c *Client) ListUploads(page, limit *int) (*UploadPage, error) {
+	return c.ListUploadsWithContext(context.Background(), page, limit)
+}
+
+/*
+Retrieve a list of uploaded images
+*/
+func (c *Client) ListUploadsWithContext(ctx context.Context, page, limit *int) (*UploadPage, error) {
+	request := &ListUploadsRequest{Page: page, Limit: limit}
+	err := c.Execute(ctx, request)
+	return request.Uploads, err
+}
+
+/*
+Retrieve an uploaded image by ID
+*/
+func (c *Client) GetUpload(uploadId string) (*Upload, error) {
+	return c.GetUploadWithContext(context.Background(), uploadId)
+}
+
+/*
+Retrieve an uploaded image by ID
+*/
+func (c *Client) GetUploadWithContext(ctx context.Context, uploadId string) (*Upload, error) {
+	request := &GetUploadRequest{UploadId: uploadId}
+	err := c.Execute(ctx, request)
+	return request.Upload, err
+}
+
+/*
+Upload an image to the media library by URL
+*/
+func (c *Client) UploadImageFromURL(fileName, imageUrl string) (*Upload, error) {
+	return c.UploadImageFromURLWithContext(context.Background(), fileName, imageUrl)
+}
+
+/*
+Upload an image to the media library by URL
+*/
+func (c *Client) UploadImageFromURLWithContext(ctx context.Context, fileName, imageUrl string) (*Upload, error) {
+	request := &UploadImageRequest{FileName: fileName, Url: imageUrl}
+	err := c.Execute(ctx, request)
+	return request.Upload, err
+}
+
+/*
+Upload an image to the media library, the contents are read from r and sent base64 encoded
+*/
+func (c *Client) UploadImageFromReader(fileName string, r io.Reader) (*Upload, error) {
+	return c.UploadImageFromReaderWithContext(context.Background(), fileName, r)
+}
+
+/*
+Upload an image to the media library, the contents are read from r and sent base64 encoded
+*/
+func (c *Client) UploadImageFromReaderWithContext(ctx context.Context, fileName string, r io.Reader) (*Upload, error) {
+	contents, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	request := &UploadImageRequest{FileName: fileName, Contents: base64.StdEncoding.EncodeToString(contents)}
+	err = c.Execute(ctx, request)
+	return request.Upload, err
+}
+
+/*
+Archive an uploaded image
+*/
+func (c *Client) ArchiveUpload(uploadId string) error {
+	return c.ArchiveUploadWithContext(context.Background(), uploadId)
+}
+
+/*
+Archive an uploaded image
+*/
+func (c *Client) ArchiveUploadWithContext(ctx context.Context, uploadId string) error {
+	return c.Execute(ctx, &ArchiveUploadRequest{UploadId: uploadId})
+}
@@ -0,0 +1,54 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_UploadImageFromReader(t *testing.T) {
+	upload := &Upload{
+		Id:         "5e16d66791287a0006e522b2",
+		FileName:   "image.png",
+		Height:     5979,
+		Width:      17045,
+		Size:       1138575,
+		MimeType:   "image/png",
+		PreviewUrl: "https://example.com/image-storage/uuid1",
+		UploadTime: "2020-01-09 07:29:43",
+	}
+	var body map[string]string
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/uploads/images.json" {
+			rw.WriteHeader(404)
+			return
+		}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{
+			"id": "5e16d66791287a0006e522b2",
+			"file_name": "image.png",
+			"height": 5979,
+			"width": 17045,
+			"size": 1138575,
+			"mime_type": "image/png",
+			"preview_url": "https://example.com/image-storage/uuid1",
+			"upload_time": "2020-01-09 07:29:43"
+		}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	res, err := client.UploadImageFromReader("image.png", strings.NewReader("png"))
+	if err != nil || !reflect.DeepEqual(res, upload) {
+		fmt.Println(res, err)
+		t.Fail()
+	}
+	if !reflect.DeepEqual(body, map[string]string{"file_name": "image.png", "contents": "cG5n"}) {
+		fmt.Println(body)
+		t.Fail()
+	}
+}
@@ -0,0 +1,413 @@
+package go_printify
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPollInterval    = time.Minute
+	defaultMaxPollInterval = 15 * time.Minute
+)
+
+type OrderChangeType string
+
+const (
+	OrderCreated          OrderChangeType = "order_created"
+	OrderStatusChanged    OrderChangeType = "order_status_changed"
+	LineItemStatusChanged OrderChangeType = "line_item_status_changed"
+	OrderShipmentCreated  OrderChangeType = "order_shipment_created"
+)
+
+/*
+OrderChange is emitted by an OrderWatcher for every difference found between two polls.
+From and To are set for status changes, LineItem for line item status changes and Shipment for new shipments.
+*/
+type OrderChange struct {
+	Type     OrderChangeType
+	ShopId   int
+	Order    *Order
+	From     OrderStatus
+	To       OrderStatus
+	LineItem *LineItem
+	Shipment *Shipment
+}
+
+/*
+OrderState is the last seen state of an order, line item statuses are keyed by line item id
+and shipments by carrier and tracking number
+*/
+type OrderState struct {
+	CreatedAt time.Time           `json:"created_at"`
+	Status    OrderStatus         `json:"status"`
+	LineItems map[int]OrderStatus `json:"line_items,omitempty"`
+	Shipments []string            `json:"shipments,omitempty"`
+}
+
+/*
+WatchCursor is the state an OrderWatcher persists between polls. Newest is the creation time of the
+newest order seen and NewestIds the ids of the orders created at that time, Orders holds the orders
+that are not canceled or fulfilled yet.
+*/
+type WatchCursor struct {
+	Newest    time.Time           `json:"newest"`
+	NewestIds []int               `json:"newest_ids,omitempty"`
+	Orders    map[int]*OrderState `json:"orders"`
+}
+
+/*
+CursorStore persists the cursor of an OrderWatcher so that a restarted watcher resumes where it stopped.
+LoadCursor returns a nil cursor when none was saved for the shop.
+*/
+type CursorStore interface {
+	LoadCursor(ctx context.Context, shopId int) (*WatchCursor, error)
+	SaveCursor(ctx context.Context, shopId int, cursor *WatchCursor) error
+}
+
+/*
+MemoryCursorStore keeps cursors in memory, it is the default store of an OrderWatcher
+*/
+type MemoryCursorStore struct {
+	mu      sync.Mutex
+	cursors map[int]*WatchCursor
+}
+
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{cursors: map[int]*WatchCursor{}}
+}
+
+func (s *MemoryCursorStore) LoadCursor(ctx context.Context, shopId int) (*WatchCursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursors[shopId], nil
+}
+
+func (s *MemoryCursorStore) SaveCursor(ctx context.Context, shopId int, cursor *WatchCursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[shopId] = cursor
+	return nil
+}
+
+/*
+WatcherOption configures an OrderWatcher created by NewOrderWatcher
+*/
+type WatcherOption func(*OrderWatcher)
+
+/*
+Persist the watcher's cursor in the given store instead of in memory
+*/
+func WithCursorStore(store CursorStore) WatcherOption {
+	return func(w *OrderWatcher) {
+		w.store = store
+	}
+}
+
+/*
+Poll every interval, after failed polls the delay doubles up to maxInterval
+*/
+func WithPollInterval(interval, maxInterval time.Duration) WatcherOption {
+	return func(w *OrderWatcher) {
+		w.interval = interval
+		w.maxInterval = maxInterval
+	}
+}
+
+/*
+Ignore orders created before since on the first poll of a shop without a saved cursor,
+instead of walking its entire order history
+*/
+func WithWatchSince(since time.Time) WatcherOption {
+	return func(w *OrderWatcher) {
+		w.since = since
+	}
+}
+
+/*
+Report failed polls to the given function
+*/
+func WithWatchErrorLog(logError func(err error)) WatcherOption {
+	return func(w *OrderWatcher) {
+		w.logError = logError
+	}
+}
+
+/*
+OrderWatcher polls a shop's orders and emits an OrderChange for every new order, status change,
+line item status change and shipment, for shops where webhooks are unavailable.
+
+	watcher := printify.NewOrderWatcher(client, shopId)
+	go watcher.Run(ctx)
+	for change := range watcher.Changes() {
+		fmt.Println(change.Type, *change.Order.Id)
+	}
+
+Open orders are diffed from the order listing, which every poll walks back to the oldest open order,
+and fetched one by one only when they are no longer listed.
+The first poll of a shop without a saved cursor walks its entire order history to record the existing
+orders without emitting changes, see WithWatchSince to bound it.
+*/
+type OrderWatcher struct {
+	client      *Client
+	shopId      int
+	store       CursorStore
+	interval    time.Duration
+	maxInterval time.Duration
+	since       time.Time
+	logError    func(err error)
+	changes     chan *OrderChange
+}
+
+/*
+Create a watcher for the orders of a shop, see the With* watcher options
+*/
+func NewOrderWatcher(client *Client, shopId int, opts ...WatcherOption) *OrderWatcher {
+	w := &OrderWatcher{
+		client:      client,
+		shopId:      shopId,
+		store:       NewMemoryCursorStore(),
+		interval:    defaultPollInterval,
+		maxInterval: defaultMaxPollInterval,
+		changes:     make(chan *OrderChange),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.maxInterval < w.interval {
+		w.maxInterval = w.interval
+	}
+	return w
+}
+
+/*
+Changes returns the channel Run emits changes on, it is closed when Run returns
+*/
+func (w *OrderWatcher) Changes() <-chan *OrderChange {
+	return w.changes
+}
+
+/*
+Run polls until ctx is done, the cursor is saved once the changes of a poll were received
+*/
+func (w *OrderWatcher) Run(ctx context.Context) error {
+	defer close(w.changes)
+	delay := w.interval
+	for {
+		err := w.runOnce(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			if w.logError != nil {
+				w.logError(err)
+			}
+			delay *= 2
+			if delay > w.maxInterval {
+				delay = w.maxInterval
+			}
+		} else {
+			delay = w.interval
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *OrderWatcher) runOnce(ctx context.Context) error {
+	changes, cursor, err := w.poll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		select {
+		case w.changes <- change:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return w.store.SaveCursor(ctx, w.shopId, cursor)
+}
+
+/*
+Poll the shop once, save the cursor and return the changes found, for callers driving their own loop instead of Run
+*/
+func (w *OrderWatcher) Poll(ctx context.Context) ([]*OrderChange, error) {
+	changes, cursor, err := w.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return changes, w.store.SaveCursor(ctx, w.shopId, cursor)
+}
+
+// poll walks the order listing back to the oldest open order the cursor
+// tracks, reporting new orders and diffing the tracked ones as they are
+// listed. Tracked orders missing from the listing are fetched one by one.
+// Nothing is returned unless the whole poll succeeded so that a failed poll
+// is repeated from the same cursor.
+func (w *OrderWatcher) poll(ctx context.Context) ([]*OrderChange, *WatchCursor, error) {
+	prev, err := w.store.LoadCursor(ctx, w.shopId)
+	if err != nil {
+		return nil, nil, err
+	}
+	first := prev == nil
+	if first {
+		prev = &WatchCursor{}
+	}
+	next := &WatchCursor{
+		Newest:    prev.Newest,
+		NewestIds: append([]int(nil), prev.NewestIds...),
+		Orders:    map[int]*OrderState{},
+	}
+	horizon := prev.Newest
+	for _, state := range prev.Orders {
+		if !state.CreatedAt.IsZero() && state.CreatedAt.Before(horizon) {
+			horizon = state.CreatedAt
+		}
+	}
+	if first {
+		horizon = w.since
+	}
+	var changes []*OrderChange
+	listed := map[int]bool{}
+	err = w.client.walkOrdersSince(ctx, w.shopId, horizon, func(order *Order) bool {
+		if order.Id == nil || listed[*order.Id] {
+			return true
+		}
+		listed[*order.Id] = true
+		if state, tracked := prev.Orders[*order.Id]; tracked {
+			changes = append(changes, diffOrder(w.shopId, state, order)...)
+			next.track(order)
+			return true
+		}
+		if !first && (order.CreatedAt == nil || prev.seen(*order.Id, *order.CreatedAt)) {
+			return true
+		}
+		next.advance(order)
+		if !first {
+			changes = append(changes, &OrderChange{Type: OrderCreated, ShopId: w.shopId, Order: order})
+		}
+		next.track(order)
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]int, 0, len(prev.Orders))
+	for id := range prev.Orders {
+		if !listed[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		order, err := w.client.GetOrderDetailsWithContext(ctx, w.shopId, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, diffOrder(w.shopId, prev.Orders[id], order)...)
+		next.track(order)
+	}
+	return changes, next, nil
+}
+
+// seen reports whether an order created at createdAt was listed by an
+// earlier poll.
+func (c *WatchCursor) seen(id int, createdAt time.Time) bool {
+	if createdAt.Before(c.Newest) {
+		return true
+	}
+	if !createdAt.Equal(c.Newest) {
+		return false
+	}
+	for _, newest := range c.NewestIds {
+		if newest == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *WatchCursor) advance(order *Order) {
+	if order.CreatedAt == nil {
+		return
+	}
+	switch {
+	case order.CreatedAt.After(c.Newest):
+		c.Newest = *order.CreatedAt
+		c.NewestIds = []int{*order.Id}
+	case order.CreatedAt.Equal(c.Newest):
+		c.NewestIds = append(c.NewestIds, *order.Id)
+	}
+}
+
+// track keeps the state of an order until it is canceled or fulfilled.
+func (c *WatchCursor) track(order *Order) {
+	if !order.status().Final() {
+		c.Orders[*order.Id] = newOrderState(order)
+	}
+}
+
+func newOrderState(order *Order) *OrderState {
+	state := &OrderState{Status: order.status(), LineItems: map[int]OrderStatus{}}
+	if order.CreatedAt != nil {
+		state.CreatedAt = *order.CreatedAt
+	}
+	for _, item := range order.LineItems {
+		if item.Id != nil && item.Status != nil {
+			state.LineItems[*item.Id] = *item.Status
+		}
+	}
+	for _, shipment := range order.Shipments {
+		state.Shipments = append(state.Shipments, shipmentKey(shipment))
+	}
+	return state
+}
+
+func shipmentKey(shipment *Shipment) string {
+	carrier := shipment.Carrier
+	if code, ok := NormalizeCarrier(carrier); ok {
+		carrier = string(code)
+	}
+	return carrier + "/" + shipment.Number
+}
+
+// diffOrder returns the changes between the last seen state of an order and
+// its current representation.
+func diffOrder(shopId int, prev *OrderState, order *Order) []*OrderChange {
+	var changes []*OrderChange
+	if status := order.status(); status != prev.Status {
+		changes = append(changes, &OrderChange{Type: OrderStatusChanged, ShopId: shopId, Order: order, From: prev.Status, To: status})
+	}
+	for _, item := range order.LineItems {
+		if item.Id == nil || item.Status == nil {
+			continue
+		}
+		if from := prev.LineItems[*item.Id]; from != *item.Status {
+			changes = append(changes, &OrderChange{
+				Type:     LineItemStatusChanged,
+				ShopId:   shopId,
+				Order:    order,
+				From:     from,
+				To:       *item.Status,
+				LineItem: item,
+			})
+		}
+	}
+	known := map[string]bool{}
+	for _, key := range prev.Shipments {
+		known[key] = true
+	}
+	for _, shipment := range order.Shipments {
+		if !known[shipmentKey(shipment)] {
+			changes = append(changes, &OrderChange{Type: OrderShipmentCreated, ShopId: shopId, Order: order, Shipment: shipment})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,194 @@
+package go_printify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	watchedPending = `{"id": 1, "status": "pending", "created_at": "2023-01-01T10:00:00Z",
+		"line_items": [{"id": 10, "quantity": 1, "status": "pending"}]}`
+	watchedInProduction = `{"id": 1, "status": "in-production", "created_at": "2023-01-01T10:00:00Z",
+		"line_items": [{"id": 10, "quantity": 1, "status": "in-production"}],
+		"shipments": [{"carrier": "usps", "number": "9400", "url": "", "delivered_at": null}]}`
+	watchedNew      = `{"id": 2, "status": "pending", "created_at": "2023-01-02T10:00:00Z", "line_items": []}`
+	watchedCanceled = `{"id": 1, "status": "canceled", "created_at": "2023-01-01T10:00:00Z", "line_items": []}`
+)
+
+type watchServer struct {
+	mu      sync.Mutex
+	orders  []string
+	detail  string
+	fetched int
+}
+
+func (ws *watchServer) set(detail string, orders ...string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.detail = detail
+	ws.orders = orders
+}
+
+func (ws *watchServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	rw.WriteHeader(200)
+	switch req.URL.Path {
+	case "/v1/shops/1/orders.json":
+		data := ""
+		for i, order := range ws.orders {
+			if i > 0 {
+				data += ","
+			}
+			data += order
+		}
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{"current_page": 1, "last_page": 1, "data": [%s]}`, data)))
+	case "/v1/shops/1/orders/1.json":
+		ws.fetched++
+		_, _ = rw.Write([]byte(ws.detail))
+	case "/v1/shops/1/orders/2.json":
+		_, _ = rw.Write([]byte(watchedNew))
+	}
+}
+
+func TestOrderWatcher_Poll(t *testing.T) {
+	ws := &watchServer{}
+	s := httptest.NewServer(ws)
+	defer s.Close()
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	store := NewMemoryCursorStore()
+	watcher := NewOrderWatcher(client, 1, WithCursorStore(store))
+
+	ws.set(watchedPending, watchedPending)
+	changes, err := watcher.Poll(context.Background())
+	if err != nil || len(changes) != 0 {
+		fmt.Println(changes, err)
+		t.Fail()
+	}
+
+	ws.set(watchedInProduction, watchedNew, watchedInProduction)
+	changes, err = watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []OrderChangeType{OrderCreated, OrderStatusChanged, LineItemStatusChanged, OrderShipmentCreated}
+	if len(changes) != len(expected) {
+		t.Fatal(changes)
+	}
+	for i, change := range changes {
+		if change.Type != expected[i] || change.ShopId != 1 {
+			fmt.Println(i, change)
+			t.Fail()
+		}
+	}
+	if changes[1].From != StatusPending || changes[1].To != StatusInProduction || *changes[2].LineItem.Id != 10 ||
+		changes[3].Shipment.Number != "9400" {
+		fmt.Println(changes[1], changes[2], changes[3])
+		t.Fail()
+	}
+	// Listed orders are diffed without fetching them
+	if ws.fetched != 0 {
+		fmt.Println(ws.fetched)
+		t.Fail()
+	}
+
+	// A restarted watcher resumes from the stored cursor
+	restarted := NewOrderWatcher(client, 1, WithCursorStore(store))
+	changes, err = restarted.Poll(context.Background())
+	if err != nil || len(changes) != 0 {
+		fmt.Println(changes, err)
+		t.Fail()
+	}
+}
+
+func TestOrderWatcher_Run(t *testing.T) {
+	ws := &watchServer{}
+	ws.set(watchedPending, watchedPending)
+	s := httptest.NewServer(ws)
+	defer s.Close()
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	store := NewMemoryCursorStore()
+	watcher := NewOrderWatcher(client, 1, WithCursorStore(store), WithPollInterval(time.Millisecond, 10*time.Millisecond))
+	if _, err := watcher.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ws.set(watchedCanceled, watchedCanceled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+	change := <-watcher.Changes()
+	if change.Type != OrderStatusChanged || change.To != StatusCanceled {
+		fmt.Println(change)
+		t.Fail()
+	}
+	cancel()
+	for range watcher.Changes() {
+	}
+	if err := <-done; err != context.Canceled {
+		fmt.Println(err)
+		t.Fail()
+	}
+	cursor, _ := store.LoadCursor(context.Background(), 1)
+	if len(cursor.Orders) != 0 {
+		fmt.Println(cursor.Orders)
+		t.Fail()
+	}
+}
+
+func TestOrderWatcher_PollUnlisted(t *testing.T) {
+	ws := &watchServer{}
+	s := httptest.NewServer(ws)
+	defer s.Close()
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	watcher := NewOrderWatcher(client, 1)
+
+	ws.set(watchedPending, watchedNew, watchedPending)
+	if _, err := watcher.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// A tracked order missing from the listing is fetched
+	ws.set(watchedCanceled, watchedNew)
+	changes, err := watcher.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Type != OrderStatusChanged || changes[0].To != StatusCanceled || ws.fetched != 1 {
+		fmt.Println(changes, ws.fetched)
+		t.Fail()
+	}
+}
+
+func TestOrderWatcher_PollSince(t *testing.T) {
+	ws := &watchServer{}
+	s := httptest.NewServer(ws)
+	defer s.Close()
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	store := NewMemoryCursorStore()
+	since := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	watcher := NewOrderWatcher(client, 1, WithCursorStore(store), WithWatchSince(since))
+
+	ws.set(watchedPending, watchedNew, watchedPending)
+	if _, err := watcher.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	cursor, _ := store.LoadCursor(context.Background(), 1)
+	if len(cursor.Orders) != 1 || cursor.Orders[2] == nil {
+		fmt.Println(cursor.Orders)
+		t.Fail()
+	}
+	// Orders created before since are not reported on later polls either
+	ws.set(watchedInProduction, watchedNew, watchedInProduction)
+	changes, err := watcher.Poll(context.Background())
+	if err != nil || len(changes) != 0 || ws.fetched != 0 {
+		fmt.Println(changes, err, ws.fetched)
+		t.Fail()
+	}
+}
@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	printify "github.com/omrikiei/go-printify"
+)
+
+// Printify sends timestamps such as "2017-04-18 13:24:28+00:00"
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+}
+
+/*
+Event is the envelope of every webhook delivery
+*/
+type Event struct {
+	Id        string                `json:"id"`
+	Type      printify.WebhookTopic `json:"type"`
+	CreatedAt Time                  `json:"created_at"`
+	Resource  Resource              `json:"resource"`
+}
+
+/*
+Resource identifies the object an event is about, Data holds its raw JSON representation
+*/
+type Resource struct {
+	Id   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+/*
+OrderEvent is delivered for the order:created, order:updated and order:sent-to-production topics
+*/
+type OrderEvent struct {
+	Event
+	Order *printify.Order
+}
+
+/*
+ShipmentEvent is delivered for the order:shipment:created and order:shipment:delivered topics
+*/
+type ShipmentEvent struct {
+	Event
+	ShopId    int
+	Shipment  *printify.Shipment
+	LineItems []*printify.LineItem
+}
+
+/*
+ProductEvent is delivered for the product:publish:started and product:deleted topics
+*/
+type ProductEvent struct {
+	Event
+	Product *printify.Product
+}
+
+/*
+ShopEvent is delivered for the shop:disconnected topic
+*/
+type ShopEvent struct {
+	Event
+}
+
+type shipmentData struct {
+	ShopId      int                  `json:"shop_id"`
+	ShippedAt   Time                 `json:"shipped_at"`
+	DeliveredAt Time                 `json:"delivered_at"`
+	Carrier     shipmentCarrier      `json:"carrier"`
+	SkuList     []*printify.LineItem `json:"skus"`
+}
+
+type shipmentCarrier struct {
+	Code           string `json:"code"`
+	TrackingNumber string `json:"tracking_number"`
+	TrackingUrl    string `json:"tracking_url"`
+}
+
+/*
+Time decodes the timestamp formats used in webhook payloads
+*/
+type Time struct {
+	time.Time
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("webhook: cannot parse time %q", value)
+}
+
+func decodeOrder(event *Event) (*OrderEvent, error) {
+	order := &printify.Order{}
+	if len(event.Resource.Data) > 0 {
+		if err := json.Unmarshal(event.Resource.Data, order); err != nil {
+			return nil, err
+		}
+	}
+	return &OrderEvent{Event: *event, Order: order}, nil
+}
+
+func decodeShipment(event *Event) (*ShipmentEvent, error) {
+	data := &shipmentData{}
+	if len(event.Resource.Data) > 0 {
+		if err := json.Unmarshal(event.Resource.Data, data); err != nil {
+			return nil, err
+		}
+	}
+	shipment := &printify.Shipment{
+		Carrier: data.Carrier.Code,
+		Number:  data.Carrier.TrackingNumber,
+		Url:     data.Carrier.TrackingUrl,
+	}
+	if !data.DeliveredAt.IsZero() {
+		deliveredAt := data.DeliveredAt.Time
+		shipment.DeliveredAt = &deliveredAt
+	}
+	return &ShipmentEvent{
+		Event:     *event,
+		ShopId:    data.ShopId,
+		Shipment:  shipment,
+		LineItems: data.SkuList,
+	}, nil
+}
+
+func decodeProduct(event *Event) (*ProductEvent, error) {
+	product := &printify.Product{}
+	if len(event.Resource.Data) > 0 {
+		if err := json.Unmarshal(event.Resource.Data, product); err != nil {
+			return nil, err
+		}
+	}
+	return &ProductEvent{Event: *event, Product: product}, nil
+}
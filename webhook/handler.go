@@ -0,0 +1,327 @@
+/*
+Package webhook receives Printify webhook deliveries.
+
+	handler := webhook.NewHandler(secret)
+	handler.OnOrder(printify.TopicOrderCreated, func(ctx context.Context, event *webhook.OrderEvent) error {
+		return nil
+	})
+	http.Handle("/printify", handler)
+*/
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	printify "github.com/omrikiei/go-printify"
+)
+
+const (
+	SignatureHeader = "X-Pf-Signature"
+	maxBodySize     = 1 << 20
+)
+
+var (
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrStaleEvent       = errors.New("webhook: event is too old")
+	ErrReplayedEvent    = errors.New("webhook: event was already delivered")
+	ErrEventInFlight    = errors.New("webhook: event is being handled")
+)
+
+/*
+Error lets a callback choose the HTTP status returned to Printify, any other error results in a 500
+*/
+type Error struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("webhook: %d: %v", e.StatusCode, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+/*
+Option configures a Handler created by NewHandler
+*/
+type Option func(*Handler)
+
+/*
+Remember handled events for tolerance, redeliveries are acknowledged without calling the callback again
+and deliveries of an event still being handled are answered with a 409. A zero tolerance disables both checks.
+*/
+func WithTolerance(tolerance time.Duration) Option {
+	return func(h *Handler) {
+		h.tolerance = tolerance
+	}
+}
+
+/*
+Acknowledge events created more than maxAge ago without calling the callback and report ErrStaleEvent.
+Printify's retries of a failed delivery keep the event's creation time, maxAge should outlast them.
+Events are not checked for their age by default.
+*/
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(h *Handler) {
+		h.maxAge = maxAge
+	}
+}
+
+/*
+Use the given clock instead of time.Now
+*/
+func WithClock(now func() time.Time) Option {
+	return func(h *Handler) {
+		h.now = now
+	}
+}
+
+/*
+Report callback and decoding errors to the given function
+*/
+func WithErrorLog(logError func(event *Event, err error)) Option {
+	return func(h *Handler) {
+		h.logError = logError
+	}
+}
+
+/*
+Handler is an http.Handler verifying, decoding and dispatching webhook deliveries
+*/
+type Handler struct {
+	secret    []byte
+	tolerance time.Duration
+	maxAge    time.Duration
+	now       func() time.Time
+	logError  func(event *Event, err error)
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	inFlight  map[string]bool
+	callbacks map[printify.WebhookTopic]func(ctx context.Context, event *Event) error
+}
+
+/*
+Create a handler verifying deliveries against the secret the webhook was created with
+*/
+func NewHandler(secret string, opts ...Option) *Handler {
+	h := &Handler{
+		secret:    []byte(secret),
+		tolerance: 10 * time.Minute,
+		now:       time.Now,
+		seen:      map[string]time.Time{},
+		inFlight:  map[string]bool{},
+		callbacks: map[printify.WebhookTopic]func(ctx context.Context, event *Event) error{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+/*
+Register a callback for one of the order:created, order:updated or order:sent-to-production topics
+*/
+func (h *Handler) OnOrder(topic printify.WebhookTopic, callback func(ctx context.Context, event *OrderEvent) error) {
+	h.register(topic, []printify.WebhookTopic{
+		printify.TopicOrderCreated,
+		printify.TopicOrderUpdated,
+		printify.TopicOrderSentToProduction,
+	}, func(ctx context.Context, event *Event) error {
+		orderEvent, err := decodeOrder(event)
+		if err != nil {
+			return &Error{StatusCode: http.StatusBadRequest, Err: err}
+		}
+		return callback(ctx, orderEvent)
+	})
+}
+
+/*
+Register a callback for one of the order:shipment:created or order:shipment:delivered topics
+*/
+func (h *Handler) OnShipment(topic printify.WebhookTopic, callback func(ctx context.Context, event *ShipmentEvent) error) {
+	h.register(topic, []printify.WebhookTopic{
+		printify.TopicOrderShipmentCreated,
+		printify.TopicOrderShipmentDelivered,
+	}, func(ctx context.Context, event *Event) error {
+		shipmentEvent, err := decodeShipment(event)
+		if err != nil {
+			return &Error{StatusCode: http.StatusBadRequest, Err: err}
+		}
+		return callback(ctx, shipmentEvent)
+	})
+}
+
+/*
+Register a callback for one of the product:publish:started or product:deleted topics
+*/
+func (h *Handler) OnProduct(topic printify.WebhookTopic, callback func(ctx context.Context, event *ProductEvent) error) {
+	h.register(topic, []printify.WebhookTopic{
+		printify.TopicProductPublishStarted,
+		printify.TopicProductDeleted,
+	}, func(ctx context.Context, event *Event) error {
+		productEvent, err := decodeProduct(event)
+		if err != nil {
+			return &Error{StatusCode: http.StatusBadRequest, Err: err}
+		}
+		return callback(ctx, productEvent)
+	})
+}
+
+/*
+Register a callback for the shop:disconnected topic
+*/
+func (h *Handler) OnShop(topic printify.WebhookTopic, callback func(ctx context.Context, event *ShopEvent) error) {
+	h.register(topic, []printify.WebhookTopic{printify.TopicShopDisconnected}, func(ctx context.Context, event *Event) error {
+		return callback(ctx, &ShopEvent{Event: *event})
+	})
+}
+
+// register panics when a callback is registered for a topic its event type
+// cannot represent, this is a programming error much like a conflicting
+// pattern given to http.ServeMux.
+func (h *Handler) register(topic printify.WebhookTopic, allowed []printify.WebhookTopic, callback func(ctx context.Context, event *Event) error) {
+	for _, t := range allowed {
+		if t == topic {
+			h.mu.Lock()
+			h.callbacks[topic] = callback
+			h.mu.Unlock()
+			return
+		}
+	}
+	panic(fmt.Sprintf("webhook: topic %q cannot be registered with this callback type", topic))
+}
+
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		rw.Header().Set("Allow", http.MethodPost)
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxBodySize))
+	if err != nil {
+		http.Error(rw, "cannot read body", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if !h.Verify(body, req.Header.Get(SignatureHeader)) {
+		h.fail(rw, nil, &Error{StatusCode: http.StatusUnauthorized, Err: ErrInvalidSignature})
+		return
+	}
+	event := &Event{}
+	if err := json.Unmarshal(body, event); err != nil {
+		h.fail(rw, nil, &Error{StatusCode: http.StatusBadRequest, Err: err})
+		return
+	}
+	// Printify redelivers on any other status than 2xx, e.g. when our answer
+	// to an earlier delivery was lost
+	if h.maxAge > 0 && !event.CreatedAt.IsZero() && h.now().Sub(event.CreatedAt.Time) > h.maxAge {
+		if h.logError != nil {
+			h.logError(event, ErrStaleEvent)
+		}
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err := h.checkReplay(event); err != nil {
+		if errors.Is(err, ErrReplayedEvent) {
+			rw.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.fail(rw, event, err)
+		return
+	}
+	handled := false
+	defer func() {
+		h.finish(event, handled)
+	}()
+	h.mu.Lock()
+	callback, ok := h.callbacks[event.Type]
+	h.mu.Unlock()
+	if !ok {
+		handled = true
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err := callback(req.Context(), event); err != nil {
+		h.fail(rw, event, err)
+		return
+	}
+	handled = true
+	rw.WriteHeader(http.StatusOK)
+}
+
+/*
+Verify reports whether signature is the HMAC-SHA256 of body keyed with the handler secret
+*/
+func (h *Handler) Verify(body []byte, signature string) bool {
+	signature = strings.TrimPrefix(strings.TrimSpace(signature), "sha256=")
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.secret)
+	_, _ = mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// checkReplay marks the event as being handled unless it was handled
+// already or is being handled by a concurrent delivery.
+func (h *Handler) checkReplay(event *Event) error {
+	if h.tolerance <= 0 || event.Id == "" {
+		return nil
+	}
+	now := h.now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id, handledAt := range h.seen {
+		if now.Sub(handledAt) > h.tolerance {
+			delete(h.seen, id)
+		}
+	}
+	if _, ok := h.seen[event.Id]; ok {
+		return ErrReplayedEvent
+	}
+	if h.inFlight[event.Id] {
+		return &Error{StatusCode: http.StatusConflict, Err: ErrEventInFlight}
+	}
+	h.inFlight[event.Id] = true
+	return nil
+}
+
+// finish remembers a handled event, a failed one is forgotten so that
+// Printify's retry is handled again.
+func (h *Handler) finish(event *Event, handled bool) {
+	if h.tolerance <= 0 || event.Id == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.inFlight, event.Id)
+	if handled {
+		h.seen[event.Id] = h.now()
+	}
+}
+
+func (h *Handler) fail(rw http.ResponseWriter, event *Event, err error) {
+	if h.logError != nil {
+		h.logError(event, err)
+	}
+	status := http.StatusInternalServerError
+	webhookErr := &Error{}
+	if errors.As(err, &webhookErr) {
+		status = webhookErr.StatusCode
+	}
+	http.Error(rw, http.StatusText(status), status)
+}
@@ -0,0 +1,176 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	printify "github.com/omrikiei/go-printify"
+)
+
+const testSecret = "s3cr3t"
+
+var testNow = time.Date(2022, 5, 17, 15, 5, 0, 0, time.UTC)
+
+func sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliver(h http.Handler, body []byte, signature string) int {
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, signature)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestHandler_ShipmentEvent(t *testing.T) {
+	body := []byte(`{
+		"id": "653b6be8-2ff7-4ab5-a7a6-6889a8b3bbf5",
+		"type": "order:shipment:created",
+		"created_at": "2022-05-17 15:00:00+00:00",
+		"resource": {
+			"id": "5a96f649b2439217d070f507",
+			"type": "order",
+			"data": {
+				"shop_id": 815256,
+				"shipped_at": "2022-05-17 15:00:00+00:00",
+				"carrier": {"code": "usps", "tracking_number": "9400", "tracking_url": "https://example.com/9400"},
+				"skus": [{"product_id": "5a96f649b2439217d070f507", "variant_id": 12, "quantity": 1}]
+			}
+		}
+	}`)
+	var received *ShipmentEvent
+	h := NewHandler(testSecret, WithClock(func() time.Time { return testNow }))
+	h.OnShipment(printify.TopicOrderShipmentCreated, func(ctx context.Context, event *ShipmentEvent) error {
+		received = event
+		return nil
+	})
+	if code := deliver(h, body, sign(body)); code != http.StatusOK {
+		fmt.Println(code)
+		t.Fail()
+	}
+	if received == nil || received.ShopId != 815256 || received.Shipment.Carrier != "usps" ||
+		received.Shipment.Number != "9400" || received.Shipment.DeliveredAt != nil || len(received.LineItems) != 1 ||
+		received.Resource.Id != "5a96f649b2439217d070f507" {
+		fmt.Println(received)
+		t.Fail()
+	}
+	// A redelivery is acknowledged without calling the callback again
+	received = nil
+	if code := deliver(h, body, sign(body)); code != http.StatusNoContent || received != nil {
+		fmt.Println(code)
+		t.Fail()
+	}
+}
+
+func TestHandler_Rejections(t *testing.T) {
+	body := []byte(`{"id": "1", "type": "order:created", "created_at": "2022-05-17 15:00:00+00:00", "resource": {"id": "1", "type": "order", "data": {"status": "pending"}}}`)
+	h := NewHandler(testSecret, WithClock(func() time.Time { return testNow }))
+	calls := 0
+	h.OnOrder(printify.TopicOrderCreated, func(ctx context.Context, event *OrderEvent) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		if event.Order.Status == nil || *event.Order.Status != "pending" {
+			return &Error{StatusCode: http.StatusUnprocessableEntity, Err: errors.New("unexpected status")}
+		}
+		return nil
+	})
+	if code := deliver(h, body, "sha256=00"); code != http.StatusUnauthorized {
+		fmt.Println(code)
+		t.Fail()
+	}
+	if code := deliver(h, body, sign(body)); code != http.StatusInternalServerError {
+		fmt.Println(code)
+		t.Fail()
+	}
+	// A failed delivery can be retried
+	if code := deliver(h, body, sign(body)); code != http.StatusOK {
+		fmt.Println(code)
+		t.Fail()
+	}
+}
+
+func TestHandler_StaleEvent(t *testing.T) {
+	body := []byte(`{"id": "1", "type": "order:created", "created_at": "2022-05-17 15:00:00+00:00", "resource": {"id": "1", "type": "order", "data": {}}}`)
+	later := func() time.Time { return testNow.Add(time.Hour) }
+	calls := 0
+	callback := func(ctx context.Context, event *OrderEvent) error {
+		calls++
+		return nil
+	}
+	// Printify's late retries are handled by default
+	h := NewHandler(testSecret, WithClock(later))
+	h.OnOrder(printify.TopicOrderCreated, callback)
+	if code := deliver(h, body, sign(body)); code != http.StatusOK || calls != 1 {
+		fmt.Println(code, calls)
+		t.Fail()
+	}
+
+	var logged error
+	stale := NewHandler(testSecret, WithClock(later), WithMaxAge(10*time.Minute), WithErrorLog(func(event *Event, err error) {
+		logged = err
+	}))
+	stale.OnOrder(printify.TopicOrderCreated, callback)
+	if code := deliver(stale, body, sign(body)); code != http.StatusNoContent || calls != 1 || logged != ErrStaleEvent {
+		fmt.Println(code, calls, logged)
+		t.Fail()
+	}
+}
+
+func TestHandler_ConcurrentDelivery(t *testing.T) {
+	body := []byte(`{"id": "1", "type": "order:created", "created_at": "2022-05-17 15:00:00+00:00", "resource": {"id": "1", "type": "order", "data": {}}}`)
+	h := NewHandler(testSecret, WithClock(func() time.Time { return testNow }))
+	started := make(chan struct{})
+	release := make(chan error)
+	h.OnOrder(printify.TopicOrderCreated, func(ctx context.Context, event *OrderEvent) error {
+		started <- struct{}{}
+		return <-release
+	})
+	first := make(chan int)
+	go func() {
+		first <- deliver(h, body, sign(body))
+	}()
+	<-started
+	// A redelivery of an event still being handled is not acknowledged
+	if code := deliver(h, body, sign(body)); code != http.StatusConflict {
+		fmt.Println(code)
+		t.Fail()
+	}
+	release <- errors.New("database unavailable")
+	if code := <-first; code != http.StatusInternalServerError {
+		fmt.Println(code)
+		t.Fail()
+	}
+	go func() {
+		<-started
+		release <- nil
+	}()
+	if code := deliver(h, body, sign(body)); code != http.StatusOK {
+		fmt.Println(code)
+		t.Fail()
+	}
+}
+
+func TestHandler_RegisterWrongTopic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+	NewHandler(testSecret).OnOrder(printify.TopicProductDeleted, func(ctx context.Context, event *OrderEvent) error {
+		return nil
+	})
+}
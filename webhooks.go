@@ -0,0 +1,188 @@
+package go_printify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	webhooksPath = "shops/%d/webhooks.json"
+	webhookPath  = "shops/%d/webhooks/%s.json"
+)
+
+type WebhookTopic string
+
+const (
+	TopicOrderCreated           WebhookTopic = "order:created"
+	TopicOrderUpdated           WebhookTopic = "order:updated"
+	TopicOrderSentToProduction  WebhookTopic = "order:sent-to-production"
+	TopicOrderShipmentCreated   WebhookTopic = "order:shipment:created"
+	TopicOrderShipmentDelivered WebhookTopic = "order:shipment:delivered"
+	TopicProductPublishStarted  WebhookTopic = "product:publish:started"
+	TopicProductDeleted         WebhookTopic = "product:deleted"
+	TopicShopDisconnected       WebhookTopic = "shop:disconnected"
+)
+
+/*
+WebhookTopics lists every topic a webhook can subscribe to
+*/
+var WebhookTopics = []WebhookTopic{
+	TopicOrderCreated,
+	TopicOrderUpdated,
+	TopicOrderSentToProduction,
+	TopicOrderShipmentCreated,
+	TopicOrderShipmentDelivered,
+	TopicProductPublishStarted,
+	TopicProductDeleted,
+	TopicShopDisconnected,
+}
+
+/*
+Valid reports whether the topic is one Printify knows about
+*/
+func (t WebhookTopic) Valid() bool {
+	for _, topic := range WebhookTopics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+type Webhook struct {
+	Id     string       `json:"id,omitempty"`
+	Topic  WebhookTopic `json:"topic"`
+	Url    string       `json:"url"`
+	ShopId int          `json:"shop_id,omitempty"`
+	Secret string       `json:"secret,omitempty"`
+}
+
+type ListWebhooksRequest struct {
+	ShopId   int
+	Webhooks []*Webhook
+}
+
+func (r *ListWebhooksRequest) GetOperation() string { return "webhooks.list" }
+func (r *ListWebhooksRequest) GetMethod() string    { return http.MethodGet }
+func (r *ListWebhooksRequest) GetPath() string      { return fmt.Sprintf(webhooksPath, r.ShopId) }
+func (r *ListWebhooksRequest) GetBody() interface{} { return nil }
+func (r *ListWebhooksRequest) GetResponseStruct() interface{} {
+	r.Webhooks = make([]*Webhook, 0)
+	return &r.Webhooks
+}
+
+type CreateWebhookRequest struct {
+	ShopId  int
+	Webhook *Webhook
+	Created *Webhook
+}
+
+func (r *CreateWebhookRequest) GetOperation() string { return "webhooks.create" }
+func (r *CreateWebhookRequest) GetMethod() string    { return http.MethodPost }
+func (r *CreateWebhookRequest) GetPath() string      { return fmt.Sprintf(webhooksPath, r.ShopId) }
+func (r *CreateWebhookRequest) GetBody() interface{} { return r.Webhook }
+func (r *CreateWebhookRequest) GetResponseStruct() interface{} {
+	r.Created = &Webhook{}
+	return r.Created
+}
+
+type UpdateWebhookRequest struct {
+	ShopId    int
+	WebhookId string
+	Url       string
+	Updated   *Webhook
+}
+
+func (r *UpdateWebhookRequest) GetOperation() string { return "webhooks.update" }
+func (r *UpdateWebhookRequest) GetMethod() string    { return http.MethodPut }
+func (r *UpdateWebhookRequest) GetPath() string {
+	return fmt.Sprintf(webhookPath, r.ShopId, r.WebhookId)
+}
+func (r *UpdateWebhookRequest) GetBody() interface{} { return map[string]string{"url": r.Url} }
+func (r *UpdateWebhookRequest) GetResponseStruct() interface{} {
+	r.Updated = &Webhook{}
+	return r.Updated
+}
+
+type DeleteWebhookRequest struct {
+	ShopId    int
+	WebhookId string
+}
+
+func (r *DeleteWebhookRequest) GetOperation() string { return "webhooks.delete" }
+func (r *DeleteWebhookRequest) GetMethod() string    { return http.MethodDelete }
+func (r *DeleteWebhookRequest) GetPath() string {
+	return fmt.Sprintf(webhookPath, r.ShopId, r.WebhookId)
+}
+func (r *DeleteWebhookRequest) GetBody() interface{}           { return nil }
+func (r *DeleteWebhookRequest) GetResponseStruct() interface{} { return nil }
+
+/*
+Retrieve a list of webhooks
+*/
+func (c *Client) ListWebhooks(shopId int) ([]*Webhook, error) {
+	return c.ListWebhooksWithContext(context.Background(), shopId)
+}
+
+/*
+Retrieve a list of webhooks
+*/
+func (c *Client) ListWebhooksWithContext(ctx context.Context, shopId int) ([]*Webhook, error) {
+	request := &ListWebhooksRequest{ShopId: shopId}
+	err := c.Execute(ctx, request)
+	return request.Webhooks, err
+}
+
+/*
+Create a new webhook
+*/
+func (c *Client) CreateWebhook(shopId int, webhook *Webhook) (*Webhook, error) {
+	return c.CreateWebhookWithContext(context.Background(), shopId, webhook)
+}
+
+/*
+Create a new webhook
+*/
+func (c *Client) CreateWebhookWithContext(ctx context.Context, shopId int, webhook *Webhook) (*Webhook, error) {
+	if webhook == nil {
+		return nil, errors.New("printify: webhook must not be nil")
+	}
+	if !webhook.Topic.Valid() {
+		return nil, fmt.Errorf("printify: unknown webhook topic %q", webhook.Topic)
+	}
+	request := &CreateWebhookRequest{ShopId: shopId, Webhook: webhook}
+	err := c.Execute(ctx, request)
+	return request.Created, err
+}
+
+/*
+Modify the URL of a webhook
+*/
+func (c *Client) UpdateWebhook(shopId int, webhookId, url string) (*Webhook, error) {
+	return c.UpdateWebhookWithContext(context.Background(), shopId, webhookId, url)
+}
+
+/*
+Modify the URL of a webhook
+*/
+func (c *Client) UpdateWebhookWithContext(ctx context.Context, shopId int, webhookId, url string) (*Webhook, error) {
+	request := &UpdateWebhookRequest{ShopId: shopId, WebhookId: webhookId, Url: url}
+	err := c.Execute(ctx, request)
+	return request.Updated, err
+}
+
+/*
+Delete a webhook
+*/
+func (c *Client) DeleteWebhook(shopId int, webhookId string) error {
+	return c.DeleteWebhookWithContext(context.Background(), shopId, webhookId)
+}
+
+/*
+Delete a webhook
+*/
+func (c *Client) DeleteWebhookWithContext(ctx context.Context, shopId int, webhookId string) error {
+	return c.Execute(ctx, &DeleteWebhookRequest{ShopId: shopId, WebhookId: webhookId})
+}
@@ -0,0 +1,51 @@
+package go_printify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_CreateWebhook(t *testing.T) {
+	webhook := &Webhook{
+		Id:     "5cb87a8cd490a2ccb256cec4",
+		Topic:  TopicOrderCreated,
+		Url:    "https://example.com/webhooks/order/created",
+		ShopId: 1,
+	}
+	var body Webhook
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/shops/1/webhooks.json" {
+			rw.WriteHeader(404)
+			return
+		}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		rw.WriteHeader(200)
+		_, _ = rw.Write([]byte(`{
+			"topic": "order:created",
+			"url": "https://example.com/webhooks/order/created",
+			"shop_id": 1,
+			"id": "5cb87a8cd490a2ccb256cec4"
+		}`))
+	}))
+	client, _ := NewClient("bla", WithBaseURL(s.URL))
+	defer s.Close()
+	res, err := client.CreateWebhook(1, &Webhook{Topic: TopicOrderCreated, Url: webhook.Url})
+	if err != nil || !reflect.DeepEqual(res, webhook) {
+		fmt.Println(res, err)
+		t.Fail()
+	}
+	if body.Topic != TopicOrderCreated || body.Url != webhook.Url {
+		fmt.Println(body)
+		t.Fail()
+	}
+	if _, err := client.CreateWebhook(1, &Webhook{Topic: "order:exploded"}); err == nil {
+		t.Fail()
+	}
+	if _, err := client.CreateWebhook(1, nil); err == nil {
+		t.Fail()
+	}
+}